@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host  = "https://myaap.example.com"
+  token = "aap-token" # or set AAP_TOKEN
+}
+
+# Restart a service on the web servers of an inventory and report the result of each host
+action "aap_ad_hoc_command" "restart_httpd" {
+  config {
+    inventory_id        = 1
+    limit               = "webservers"
+    module_name         = "service"
+    module_args         = "name=httpd state=restarted"
+    credential          = 2
+    become              = true
+    wait_for_completion = true
+  }
+}
+
+# Configure the action to trigger after a resource is created
+resource "terraform_data" "trigger" {
+  input = "example"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aap_ad_hoc_command.restart_httpd]
+    }
+  }
+}
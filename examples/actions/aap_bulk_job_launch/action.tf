@@ -0,0 +1,41 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host  = "https://myaap.example.com"
+  token = "aap-token" # or set AAP_TOKEN
+}
+
+variable "release_inventories" {
+  type    = list(number)
+  default = [11, 12, 13]
+}
+
+# Launch the same job template against many inventories in a single workflow job
+action "aap_bulk_job_launch" "release" {
+  config {
+    name = "Release 1.2.0"
+    jobs = [for inventory_id in var.release_inventories : {
+      job_template_id = 7
+      inventory_id    = inventory_id
+      extra_vars      = jsonencode({ "release" : "1.2.0" })
+    }]
+    wait_for_completion = true
+  }
+}
+
+# Configure the action to trigger after a resource is created
+resource "terraform_data" "trigger" {
+  input = "example"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aap_bulk_job_launch.release]
+    }
+  }
+}
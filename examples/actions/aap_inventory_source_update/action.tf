@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host  = "https://myaap.example.com"
+  token = "aap-token" # or set AAP_TOKEN
+}
+
+# Sync a dynamic inventory from its source
+action "aap_inventory_source_update" "cloud" {
+  config {
+    inventory_source_name = "AWS EC2"
+    inventory_name        = "Cloud"
+    organization_name     = "Default"
+    wait_for_completion   = true
+  }
+}
+
+# Configure the action to trigger after a resource is created
+resource "terraform_data" "trigger" {
+  input = "example"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aap_inventory_source_update.cloud]
+    }
+  }
+}
@@ -16,6 +16,8 @@ action "aap_job_launch" "test" {
   config {
     job_template_id     = 1234
     wait_for_completion = true
+    # Report the play and task names as progress while the job runs
+    stream_job_events = "tasks"
   }
 }
 
@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host  = "https://myaap.example.com"
+  token = "aap-token" # or set AAP_TOKEN
+}
+
+# Relaunch a job on the hosts that failed, and again up to 2 times while it does not succeed
+action "aap_job_relaunch" "retry_failed_hosts" {
+  config {
+    job_id              = 4321
+    hosts               = "failed"
+    wait_for_completion = true
+    retries             = 2
+    retry_delay_seconds = 60
+  }
+}
+
+# Configure the action to trigger after a resource is created
+resource "terraform_data" "trigger" {
+  input = "example"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aap_job_relaunch.retry_failed_hosts]
+    }
+  }
+}
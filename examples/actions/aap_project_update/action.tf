@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host  = "https://myaap.example.com"
+  token = "aap-token" # or set AAP_TOKEN
+}
+
+# Update a project from its source control after pushing playbook changes
+action "aap_project_update" "playbooks" {
+  config {
+    project_name        = "Playbooks"
+    organization_name   = "Default"
+    wait_for_completion = true
+  }
+}
+
+# Configure the action to trigger after a resource is created
+resource "terraform_data" "trigger" {
+  input = "example"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aap_project_update.playbooks]
+    }
+  }
+}
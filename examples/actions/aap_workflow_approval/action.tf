@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host  = "https://myaap.example.com"
+  token = "aap-token" # or set AAP_TOKEN
+}
+
+# Launch a workflow job that pauses on an approval node
+resource "aap_workflow_job" "deploy" {
+  workflow_job_template_name = "Deploy with sign-off"
+  organization_name          = "Default"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aap_workflow_approval.sign_off]
+    }
+  }
+}
+
+# Approve the pending approvals of the workflow job and wait for it to complete
+action "aap_workflow_approval" "sign_off" {
+  config {
+    workflow_job_id     = tonumber(regex("[0-9]+", aap_workflow_job.deploy.url))
+    approval_name       = "Production sign-off"
+    decision            = "approve"
+    wait_for_completion = true
+  }
+}
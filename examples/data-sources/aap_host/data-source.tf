@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host     = "https://AAP_HOST"
+  username = "ansible"
+  password = "test123!"
+
+# Look up a host by its id.
+data "aap_host" "by_id" {
+  id = 1
+}
+
+output "host_facts" {
+  value = jsondecode(data.aap_host.by_id.ansible_facts)
+}
+
+# Look up a host by its name within an inventory, without retrieving its facts.
+data "aap_host" "by_name" {
+  name          = "tf_host_foo"
+  inventory_id  = 1
+  exclude_facts = true
+}
+
+output "last_job" {
+  value = {
+    id     = data.aap_host.by_name.last_job_id
+    status = data.aap_host.by_name.last_job_status
+    failed = data.aap_host.by_name.has_active_failures
+  }
+}
@@ -0,0 +1,39 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host     = "https://AAP_HOST"
+  username = "ansible"
+  password = "test123!"
+}
+
+# List all the hosts of an inventory.
+data "aap_hosts" "all" {
+  inventory_id = 1
+}
+
+output "host_names" {
+  value = [for host in data.aap_hosts.all.hosts : host.name]
+}
+
+# Filters are applied by AAP and can be combined.
+data "aap_hosts" "failed_webservers" {
+  inventory_id        = 1
+  name_contains       = "web"
+  enabled             = true
+  has_active_failures = true
+}
+
+output "failed_webservers" {
+  value = data.aap_hosts.failed_webservers.hosts[*].id
+}
+
+# Hosts from all inventories can be filtered with the AAP host filter syntax.
+data "aap_hosts" "by_host_filter" {
+  host_filter = "groups__name=webservers and ansible_facts__ansible_distribution=RedHat"
+}
@@ -30,3 +30,21 @@ data "aap_inventory" "sample_with_name_and_org_name" {
 output "inventory_details_with_name_and_org_name" {
   value = data.aap_inventory.sample_with_name_and_org_name
 }
+
+# Set include_contents to retrieve the hosts, groups and rendered inventory script.
+
+data "aap_inventory" "sample_with_contents" {
+  id               = 1
+  include_contents = true
+}
+
+output "inventory_host_names" {
+  value = keys(data.aap_inventory.sample_with_contents.hosts)
+}
+
+check "inventory_has_web_servers" {
+  assert {
+    condition     = contains(keys(data.aap_inventory.sample_with_contents.groups), "web")
+    error_message = "The inventory has no web group."
+  }
+}
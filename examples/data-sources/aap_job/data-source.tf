@@ -0,0 +1,38 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host     = "https://AAP_HOST"
+  username = "ansible"
+  password = "test123!"
+}
+
+# Look up a Job launched outside of Terraform, for example by a schedule.
+data "aap_job" "nightly" {
+  id = 42
+
+  # Retrieve the last 20 lines of the Job standard output.
+  stdout_lines = 20
+}
+
+output "job_status" {
+  value = data.aap_job.nightly.status
+}
+
+# Values set by the `set_stats` module are available as JSON encoded artifacts.
+output "job_artifacts" {
+  value = jsondecode(data.aap_job.nightly.artifacts)
+}
+
+output "failed_hosts" {
+  value = [for host, summary in data.aap_job.nightly.host_summaries : host if summary.failed > 0 || summary.unreachable > 0]
+}
+
+output "job_stdout" {
+  value = data.aap_job.nightly.stdout
+}
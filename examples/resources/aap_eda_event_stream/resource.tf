@@ -0,0 +1,59 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host  = "https://myaap.example.com"
+  token = "aap-token" # or set AAP_TOKEN
+}
+
+variable "event_stream_username" {
+  type      = string
+  sensitive = true
+}
+
+variable "event_stream_password" {
+  type      = string
+  sensitive = true
+}
+
+# The event stream authenticates the received events with an EDA credential
+# of an event stream credential type, for example "Basic Event Stream".
+resource "aap_eda_event_stream" "example" {
+  name              = "Terraform Event Stream"
+  organization_id   = 1
+  eda_credential_id = 2
+
+  # Set to true to only record the received events without forwarding
+  # them to the rulebook activations.
+  test_mode = false
+}
+
+# Send events to the event stream created above.
+action "aap_eda_eventstream_post" "create" {
+  config {
+    limit             = "infra"
+    template_type     = "job"
+    job_template_name = "After Create Job Template"
+    organization_name = "Default"
+    event_stream_config = {
+      username = var.event_stream_username
+      password = var.event_stream_password
+      url      = aap_eda_event_stream.example.url
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "example"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aap_eda_eventstream_post.create]
+    }
+  }
+}
@@ -59,6 +59,27 @@ resource "aap_group" "sample_xyz" {
   variables    = "os: Linux\nautomation: ansible-devel"
 }
 
+resource "aap_group" "sample_map" {
+  inventory_id = aap_inventory.my_inventory.id
+  name         = "tf_group_map"
+  variables_map = {
+    ansible_network_os = "ios"
+    ansible_port       = 22
+  }
+}
+
+resource "aap_host" "sample_host" {
+  inventory_id = aap_inventory.my_inventory.id
+  name         = "tf_host_sample"
+}
+
+resource "aap_group" "sample_parent" {
+  inventory_id = aap_inventory.my_inventory.id
+  name         = "tf_group_parent"
+  children     = [aap_group.sample_foo.id, aap_group.sample_bar.id]
+  hosts        = [aap_host.sample_host.id]
+}
+
 output "group_foo" {
   value = aap_group.sample_foo
 }
@@ -69,9 +69,49 @@ resource "aap_host" "sample_abc" {
 }
 
 resource "aap_host" "sample_xyz" {
+  inventory_id  = aap_inventory.my_inventory.id
+  name          = "tf_host_xyz"
+  variables     = "os: Linux\nautomation: ansible-devel"
+  exclude_facts = true
+}
+
+resource "aap_host" "sample_map" {
+  inventory_id = aap_inventory.my_inventory.id
+  name         = "tf_host_map"
+  variables_map = {
+    ansible_host = "10.0.0.10"
+    ansible_port = 2222
+    os           = { family = "RedHat", major = 9 }
+  }
+}
+
+# Only manage the ansible_user variable, other variables set in AAP are left untouched.
+resource "aap_host" "sample_managed_keys" {
+  inventory_id           = aap_inventory.my_inventory.id
+  name                   = "tf_host_managed_keys"
+  variables_managed_keys = true
+  variables_map = {
+    ansible_user = "admin"
+  }
+}
+
+variable "become_password" {
+  type      = string
+  sensitive = true
+}
+
+# The become password is sent to AAP but never stored in the Terraform state.
+# Increment sensitive_variables_version to send it again after rotating it.
+resource "aap_host" "sample_sensitive" {
   inventory_id = aap_inventory.my_inventory.id
-  name         = "tf_host_xyz"
-  variables    = "os: Linux\nautomation: ansible-devel"
+  name         = "tf_host_sensitive"
+  variables = jsonencode({
+    ansible_user = "admin"
+  })
+  sensitive_variables = {
+    ansible_become_password = var.become_password
+  }
+  sensitive_variables_version = 1
 }
 
 output "host_foo" {
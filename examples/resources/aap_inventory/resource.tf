@@ -63,6 +63,16 @@ resource "aap_inventory" "sample_xyz" {
   variables    = "os: Linux\nautomation: ansible-devel"
 }
 
+resource "aap_inventory" "sample_map" {
+  name         = "My new inventory map"
+  description  = "A new inventory for testing"
+  organization = 1
+  variables_map = {
+    os       = "Linux"
+    packages = ["git", "vim"]
+  }
+}
+
 output "inventory_foo" {
   value = aap_inventory.sample_foo
 }
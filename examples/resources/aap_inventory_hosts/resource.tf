@@ -0,0 +1,29 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host     = "https://AAP_HOST"
+  username = "ansible"
+  password = "test123!"
+
+resource "aap_inventory" "my_inventory" {
+  organization = 1
+  name         = "A large inventory"
+}
+
+resource "aap_inventory_hosts" "web_servers" {
+  inventory_id = aap_inventory.my_inventory.id
+  hosts = [for i in range(500) : {
+    name      = "web-${i}.example.com"
+    variables = yamlencode({ "rack" : floor(i / 40) })
+  }]
+}
+
+output "web_server_ids" {
+  value = aap_inventory_hosts.web_servers.host_ids
+}
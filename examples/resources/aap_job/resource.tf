@@ -62,6 +62,13 @@ resource "aap_job" "sample_xyz" {
   extra_vars      = "os: Linux\nautomation: ansible-devel"
 }
 
+# The job template can be referenced by name instead of ID
+resource "aap_job" "sample_by_name" {
+  job_template_name = "Deploy application"
+  organization_name = "Default"
+  inventory_id      = aap_inventory.my_inventory.id
+}
+
 resource "aap_job" "sample_wait_for_completion" {
   job_template_id                     = 7
   inventory_id                        = aap_inventory.my_inventory.id
@@ -69,6 +76,35 @@ resource "aap_job" "sample_wait_for_completion" {
   wait_for_completion_timeout_seconds = 120
 }
 
+# The results of a job are available when waiting for completion. Values set by
+# the `set_stats` module in the playbook are exposed as JSON encoded artifacts.
+resource "aap_job" "sample_provisioning" {
+  job_template_id     = 7
+  inventory_id        = aap_inventory.my_inventory.id
+  wait_for_completion = true
+}
+
+# A job that does not succeed can be relaunched on the hosts that failed before failing the apply
+resource "aap_job" "sample_relaunch" {
+  job_template_id     = 7
+  inventory_id        = aap_inventory.my_inventory.id
+  wait_for_completion = true
+  relaunch_on = {
+    hosts               = "failed"
+    retries             = 2
+    retry_delay_seconds = 30
+  }
+}
+
+resource "aap_host" "provisioned" {
+  inventory_id = aap_inventory.my_inventory.id
+  name         = jsondecode(aap_job.sample_provisioning.artifacts).vm_ip
+}
+
+output "job_failed_hosts" {
+  value = [for host, count in aap_job.sample_provisioning.host_summaries.failures : host if count > 0]
+}
+
 # Comprehensive example with all prompt on launch fields
 resource "aap_job" "sample_comprehensive" {
   job_template_id                     = 7
@@ -56,6 +56,12 @@ resource "aap_workflow_job" "sample_xyz" {
   extra_vars               = "os: Linux\nautomation: ansible-devel"
 }
 
+# The workflow job template can be referenced by name instead of ID
+resource "aap_workflow_job" "sample_by_name" {
+  workflow_job_template_name = "Deploy stack"
+  organization_name          = "Default"
+}
+
 output "job_foo" {
   value = aap_workflow_job.sample_foo
 }
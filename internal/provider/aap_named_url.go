@@ -15,10 +15,18 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"path"
 	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
 // ---------------------------------------------------------------------------
@@ -91,3 +99,94 @@ func (o *OrganizationDataSourceModel) CreateNamedURL(uri string, apiModel *Organ
 func (o *BaseResourceSourceModel) CreateNamedURL(uri string, apiModel *BaseResourceAPIModel) (string, error) {
 	return apiModel.CreateNamedURL(uri)
 }
+
+// ---------------------------------------------------------------------------
+// Template lookup
+// ---------------------------------------------------------------------------
+
+// ResolveTemplateID returns the ID of the template with the given name in the given organization,
+// using the named URL of the template under the templates URI.
+func ResolveTemplateID(client ProviderHTTPClient, templatesURI string, name string, organizationName string) (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiModel := &BaseDetailAPIModelWithOrg{
+		BaseDetailAPIModel: BaseDetailAPIModel{Name: name},
+		SummaryFields: SummaryFieldsAPIModel{
+			Organization: SummaryField{Name: organizationName},
+		},
+	}
+	namedURL, err := apiModel.CreateNamedURL(templatesURI)
+	if err != nil {
+		diags.AddError("Error resolving the template ID", err.Error())
+		return 0, diags
+	}
+
+	return ResolveNamedURLID(client, namedURL)
+}
+
+// ResolveNamedURLID returns the ID of the resource at the given named URL.
+func ResolveNamedURLID(client ProviderHTTPClient, namedURL string) (int64, diag.Diagnostics) {
+	body, diags := client.Get(namedURL)
+	if diags.HasError() {
+		return 0, diags
+	}
+
+	var detail BaseDetailAPIModel
+	err := json.Unmarshal(body, &detail)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return 0, diags
+	}
+
+	return detail.ID, diags
+}
+
+// templateConfigValidators returns the configuration validators of a resource launching a template,
+// which is referenced either by ID or by name + organization_name.
+func templateConfigValidators(idAttribute string, nameAttribute string) []resource.ConfigValidator {
+	return namedConfigValidators(idAttribute, nameAttribute, "organization_name")
+}
+
+// namedConfigValidators returns the configuration validators of a resource referencing another one either
+// by ID or by the attributes its named URL is made of, which are required together.
+func namedConfigValidators(idAttribute string, nameAttributes ...string) []resource.ConfigValidator {
+	var namePaths []tfpath.Expression
+	for _, nameAttribute := range nameAttributes {
+		namePaths = append(namePaths, tfpath.MatchRoot(nameAttribute))
+	}
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			tfpath.MatchRoot(idAttribute),
+			tfpath.MatchRoot(nameAttributes[0])),
+		resourcevalidator.RequiredTogether(namePaths...),
+	}
+}
+
+// actionConfigValidator runs a resource configuration validator against the configuration of an action,
+// since the framework validators do not provide action validators.
+type actionConfigValidator struct {
+	resource.ConfigValidator
+}
+
+// ValidateAction validates the configuration of the action.
+func (v actionConfigValidator) ValidateAction(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var validateResp resource.ValidateConfigResponse
+	v.ValidateResource(ctx, resource.ValidateConfigRequest{Config: req.Config}, &validateResp)
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+// templateActionConfigValidators returns the configuration validators of an action launching a template,
+// which is referenced either by ID or by name + organization_name.
+func templateActionConfigValidators(idAttribute string, nameAttribute string) []action.ConfigValidator {
+	return namedActionConfigValidators(idAttribute, nameAttribute, "organization_name")
+}
+
+// namedActionConfigValidators returns the configuration validators of an action referencing a resource either
+// by ID or by the attributes its named URL is made of, which are required together.
+func namedActionConfigValidators(idAttribute string, nameAttributes ...string) []action.ConfigValidator {
+	var validators []action.ConfigValidator
+	for _, validator := range namedConfigValidators(idAttribute, nameAttributes...) {
+		validators = append(validators, actionConfigValidator{validator})
+	}
+	return validators
+}
@@ -3,6 +3,9 @@ package provider
 import (
 	"errors"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"go.uber.org/mock/gomock"
 )
 
 func TestCreateNamedURLBaseDetailModelAPIModel(t *testing.T) {
@@ -220,3 +223,24 @@ func TestCreateNamedURLBaseDetailAPIModelWithOrg(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveTemplateID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockProviderHTTPClient(ctrl)
+	mockClient.EXPECT().Get("/api/v2/job_templates/Deploy app++Default").Return([]byte(`{"id": 7, "name": "Deploy app"}`), diag.Diagnostics{})
+
+	templateID, diags := ResolveTemplateID(mockClient, "/api/v2/job_templates", "Deploy app", "Default")
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+	if templateID != 7 {
+		t.Errorf("Expected template ID 7 but got %d", templateID)
+	}
+
+	_, diags = ResolveTemplateID(mockClient, "/api/v2/job_templates", "Deploy app", "")
+	if !diags.HasError() {
+		t.Error("Expected an error when the organization name is missing")
+	}
+}
@@ -0,0 +1,334 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// adHocCommandHostEvents are the events reporting the result of an ad hoc command on a host,
+// mapped to the reported result.
+var adHocCommandHostEvents = map[string]string{
+	"runner_on_ok":          "ok",
+	"runner_on_failed":      "failed",
+	"runner_on_unreachable": "unreachable",
+	"runner_on_skipped":     "skipped",
+}
+
+// AdHocCommandAPIModel represents the AAP API model of an ad hoc command.
+// /api/controller/v2/ad_hoc_commands/
+type AdHocCommandAPIModel struct {
+	URL                  string `json:"url,omitempty"`
+	Status               string `json:"status,omitempty"`
+	Inventory            int64  `json:"inventory"`
+	Limit                string `json:"limit,omitempty"`
+	ModuleName           string `json:"module_name"`
+	ModuleArgs           string `json:"module_args,omitempty"`
+	Credential           int64  `json:"credential,omitempty"`
+	BecomeEnabled        bool   `json:"become_enabled,omitempty"`
+	Forks                int64  `json:"forks,omitempty"`
+	Verbosity            int64  `json:"verbosity,omitempty"`
+	ExecutionEnvironment int64  `json:"execution_environment,omitempty"`
+}
+
+// AdHocCommandHostResult is the result of an ad hoc command on a host.
+type AdHocCommandHostResult struct {
+	Host   string
+	Result string
+}
+
+// AdHocCommandAction represents an ad hoc command action that can be executed in AAP.
+type AdHocCommandAction struct {
+	client ProviderHTTPClient
+}
+
+func NewAdHocCommandAction() action.Action {
+	return &AdHocCommandAction{}
+}
+
+var (
+	_ action.Action = (*AdHocCommandAction)(nil)
+)
+
+type AdHocCommandActionModel struct {
+	InventoryID              types.Int64  `tfsdk:"inventory_id"`
+	Limit                    types.String `tfsdk:"limit"`
+	ModuleName               types.String `tfsdk:"module_name"`
+	ModuleArgs               types.String `tfsdk:"module_args"`
+	CredentialID             types.Int64  `tfsdk:"credential"`
+	Become                   types.Bool   `tfsdk:"become"`
+	Forks                    types.Int64  `tfsdk:"forks"`
+	Verbosity                types.Int64  `tfsdk:"verbosity"`
+	ExecutionEnvironmentID   types.Int64  `tfsdk:"execution_environment"`
+	WaitForCompletion        types.Bool   `tfsdk:"wait_for_completion"`
+	WaitForCompletionTimeout types.Int64  `tfsdk:"wait_for_completion_timeout_seconds"`
+	IgnoreJobResults         types.Bool   `tfsdk:"ignore_job_results"`
+}
+
+// Schema defines the schema for the ad hoc command action
+func (a *AdHocCommandAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"inventory_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Identifier for the inventory the command is run against.",
+			},
+			"limit": schema.StringAttribute{
+				Optional:    true,
+				Description: "Limit pattern to restrict the command to specific hosts of the inventory.",
+			},
+			"module_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Name of the Ansible module to run, such as `command`, `shell` or `service`.",
+			},
+			"module_args": schema.StringAttribute{
+				Optional:    true,
+				Description: "Arguments of the module, such as `name=httpd state=restarted`.",
+			},
+			"credential": schema.Int64Attribute{
+				Optional:    true,
+				Description: "ID of the machine credential used to connect to the hosts.",
+			},
+			"become": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Run the command with privilege escalation.",
+			},
+			"forks": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of parallel processes to use for the command.",
+			},
+			"verbosity": schema.Int64Attribute{
+				Description: "Verbosity level for the command. Valid values: 0 (Normal), 1 (Verbose), 2 (More Verbose), 3 (Debug), 4 (Connection Debug), 5 (WinRM Debug).",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, VerbosityMax),
+				},
+			},
+			"execution_environment": schema.Int64Attribute{
+				Description: "ID of the execution environment to use for the command.",
+				Optional:    true,
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+				Description: "When this is set to `true`, Terraform will wait until the command reaches " +
+					"any final status, reports the result on each host and then, proceeds with the following resource operation",
+			},
+			"wait_for_completion_timeout_seconds": schema.Int64Attribute{
+				Optional: true,
+				Description: "Sets the maximum amount of seconds Terraform will wait before timing out the updates, " +
+					"and the command will fail. Default value of `120`",
+			},
+			"ignore_job_results": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "When this is set to `true`, and wait_for_completion is `true`, ignore the command status.",
+			},
+		},
+		MarkdownDescription: "Runs an AAP ad hoc command.\n\n" +
+			"This action runs a single Ansible module against the hosts of an inventory, such as restarting a service. \n" +
+			"Moreover, you can set `wait_for_completion` to true, then Terraform will " +
+			"wait until the command reaches any final state before continuing, and report the result " +
+			"on each host as progress. " +
+			"You can also tweak `wait_for_completion_timeout_seconds` to control the timeout limit.",
+	}
+}
+
+// CreateRequestBody creates a JSON encoded request body from the ad hoc command action data.
+func (r *AdHocCommandActionModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	adHocCommand := AdHocCommandAPIModel{
+		Inventory:            r.InventoryID.ValueInt64(),
+		Limit:                r.Limit.ValueString(),
+		ModuleName:           r.ModuleName.ValueString(),
+		ModuleArgs:           r.ModuleArgs.ValueString(),
+		Credential:           r.CredentialID.ValueInt64(),
+		BecomeEnabled:        r.Become.ValueBool(),
+		Forks:                r.Forks.ValueInt64(),
+		Verbosity:            r.Verbosity.ValueInt64(),
+		ExecutionEnvironment: r.ExecutionEnvironmentID.ValueInt64(),
+	}
+
+	requestBody, err := json.Marshal(adHocCommand)
+	if err != nil {
+		diags.AddError("Error marshaling request body", err.Error())
+		return nil, diags
+	}
+	return requestBody, diags
+}
+
+// GetAdHocCommandHostResults returns the result of the ad hoc command at the given URL on each host,
+// in the order the hosts reported it.
+func GetAdHocCommandHostResults(client ProviderHTTPClient, adHocCommandURL string) ([]AdHocCommandHostResult, diag.Diagnostics) {
+	var results []AdHocCommandHostResult
+	var counter int64
+	eventsURL := path.Join(adHocCommandURL, "events")
+
+	for {
+		body, diags := client.GetWithParams(eventsURL, map[string]string{
+			"counter__gt": strconv.FormatInt(counter, 10),
+			"order_by":    "counter",
+			"page_size":   jobEventsPageSize,
+		})
+		if diags.HasError() {
+			return results, diags
+		}
+
+		var events JobEventListAPIModel
+		err := json.Unmarshal(body, &events)
+		if err != nil {
+			diags.AddError("Error parsing JSON response from AAP", err.Error())
+			return results, diags
+		}
+
+		for _, event := range events.Results {
+			counter = event.Counter
+			result, ok := adHocCommandHostEvents[event.Event]
+			if !ok || event.HostName == "" {
+				continue
+			}
+			if result == "ok" && event.Changed {
+				result = "changed"
+			}
+			results = append(results, AdHocCommandHostResult{Host: event.HostName, Result: result})
+		}
+
+		if events.Next == "" || len(events.Results) == 0 {
+			return results, diags
+		}
+	}
+}
+
+// Invoke executes the ad hoc command action.
+func (a *AdHocCommandAction) Invoke(ctx context.Context, req action.InvokeRequest, response *action.InvokeResponse) {
+	var config AdHocCommandActionModel
+
+	response.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set default timeout if not provided
+	if config.WaitForCompletionTimeout.IsNull() {
+		config.WaitForCompletionTimeout = types.Int64Value(waitForCompletionTimeoutDefault)
+	}
+
+	requestBody, diags := config.CreateRequestBody()
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := a.client.Create(path.Join(a.client.getAPIEndpoint(), "ad_hoc_commands"), bytes.NewReader(requestBody))
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var adHocCommand AdHocCommandAPIModel
+	err := json.Unmarshal(body, &adHocCommand)
+	if err != nil {
+		response.Diagnostics.AddError("Error parsing JSON response from AAP", err.Error())
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Ad hoc command launched, URL: %s, Module: %s, Inventory ID: %d", adHocCommand.URL, adHocCommand.ModuleName, adHocCommand.Inventory),
+	})
+
+	tflog.Debug(ctx, "ad hoc command launched", map[string]interface{}{
+		"url":          adHocCommand.URL,
+		"status":       adHocCommand.Status,
+		"module_name":  adHocCommand.ModuleName,
+		"inventory_id": adHocCommand.Inventory,
+	})
+
+	if !config.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	timeout := time.Duration(config.WaitForCompletionTimeout.ValueInt64()) * time.Second
+	var status string
+	retryProgressFunc := func(status string) {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Ad hoc command at: %s is in status: %s", adHocCommand.URL, status),
+		})
+	}
+	err = retry.RetryContext(
+		ctx,
+		timeout,
+		retryUntilAAPJobReachesAnyFinalState(ctx, a.client, retryProgressFunc, adHocCommand.URL, &status),
+	)
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("error when waiting for AAP ad hoc command to complete", err.Error()))
+		return
+	}
+
+	results, diags := GetAdHocCommandHostResults(a.client, adHocCommand.URL)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	for _, result := range results {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Host %s: %s", result.Host, result.Result),
+		})
+	}
+
+	if status != statusSuccessfulConst {
+		if config.IgnoreJobResults.ValueBool() {
+			response.Diagnostics.Append(
+				diag.NewWarningDiagnostic(
+					fmt.Sprintf("AAP ad hoc command %s", status),
+					fmt.Sprintf("API Path: %s", adHocCommand.URL),
+				),
+			)
+		} else {
+			response.Diagnostics.Append(
+				diag.NewErrorDiagnostic(
+					fmt.Sprintf("AAP ad hoc command %s", status),
+					fmt.Sprintf("API Path: %s", adHocCommand.URL),
+				),
+			)
+		}
+	}
+}
+
+// Configure configures the ad hoc command action with the provider client
+func (a *AdHocCommandAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+// Metadata returns the action metadata
+func (a *AdHocCommandAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ad_hoc_command"
+}
@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+
+	fwaction "github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.uber.org/mock/gomock"
+)
+
+// TestAdHocCommandActionSchema tests the Schema function
+func TestAdHocCommandActionSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	schemaResponse := fwaction.SchemaResponse{}
+	NewAdHocCommandAction().Schema(ctx, fwaction.SchemaRequest{}, &schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+// TestAdHocCommandActionMetadata tests the Metadata function
+func TestAdHocCommandActionMetadata(t *testing.T) {
+	t.Parallel()
+
+	metadataResponse := fwaction.MetadataResponse{}
+	NewAdHocCommandAction().Metadata(t.Context(), fwaction.MetadataRequest{ProviderTypeName: "aap"}, &metadataResponse)
+
+	expected := "aap_ad_hoc_command"
+	if metadataResponse.TypeName != expected {
+		t.Errorf("Expected metadata TypeName %q, received %q", expected, metadataResponse.TypeName)
+	}
+}
+
+func TestAdHocCommandActionCreateRequestBody(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    AdHocCommandActionModel
+		expected []byte
+	}{
+		{
+			name: "required values",
+			input: AdHocCommandActionModel{
+				InventoryID: types.Int64Value(1),
+				ModuleName:  types.StringValue("ping"),
+			},
+			expected: []byte(`{"inventory":1,"module_name":"ping"}`),
+		},
+		{
+			name: "all values",
+			input: AdHocCommandActionModel{
+				InventoryID:            types.Int64Value(1),
+				Limit:                  types.StringValue("webservers"),
+				ModuleName:             types.StringValue("service"),
+				ModuleArgs:             types.StringValue("name=httpd state=restarted"),
+				CredentialID:           types.Int64Value(2),
+				Become:                 types.BoolValue(true),
+				Forks:                  types.Int64Value(5),
+				Verbosity:              types.Int64Value(1),
+				ExecutionEnvironmentID: types.Int64Value(3),
+			},
+			expected: []byte(`{"inventory":1,"limit":"webservers","module_name":"service","module_args":"name=httpd state=restarted",` +
+				`"credential":2,"become_enabled":true,"forks":5,"verbosity":1,"execution_environment":3}`),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody()
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			if string(test.expected) != string(actual) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestGetAdHocCommandHostResults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventsURL := "/api/v2/ad_hoc_commands/5/events"
+	params := func(counter string) map[string]string {
+		return map[string]string{"counter__gt": counter, "order_by": "counter", "page_size": "200"}
+	}
+
+	mockClient := NewMockProviderHTTPClient(ctrl)
+	gomock.InOrder(
+		mockClient.EXPECT().GetWithParams(eventsURL, params("0")).Return(
+			[]byte(`{"next":"/api/v2/ad_hoc_commands/5/events/?page=2","results":[`+
+				`{"counter":1,"event":"playbook_on_start"},`+
+				`{"counter":2,"event":"runner_on_ok","host_name":"web1","changed":true},`+
+				`{"counter":3,"event":"runner_on_ok","host_name":"web2","changed":false}]}`),
+			diag.Diagnostics{},
+		),
+		mockClient.EXPECT().GetWithParams(eventsURL, params("3")).Return(
+			[]byte(`{"next":null,"results":[{"counter":4,"event":"runner_on_unreachable","host_name":"web3"},`+
+				`{"counter":5,"event":"playbook_on_stats"}]}`),
+			diag.Diagnostics{},
+		),
+	)
+
+	results, diags := GetAdHocCommandHostResults(mockClient, "/api/v2/ad_hoc_commands/5/")
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+
+	expected := []AdHocCommandHostResult{
+		{Host: "web1", Result: "changed"},
+		{Host: "web2", Result: "ok"},
+		{Host: "web3", Result: "unreachable"},
+	}
+	if !reflect.DeepEqual(expected, results) {
+		t.Errorf("Expected (%v) not equal to actual (%v)", expected, results)
+	}
+}
+
+// TestAdHocCommandActionInvoke tests the full Invoke function
+func TestAdHocCommandActionInvoke(t *testing.T) {
+	inventoryID := int64(1)
+	moduleName := "ping"
+	waitTrue := true
+	ignoreTrue := true
+
+	testTable := []struct {
+		name             string
+		wait             *bool
+		ignoreJobResults *bool
+		status           string
+		expectedProgress []string
+		expectError      bool
+		expectWarning    bool
+	}{
+		{
+			name:             "launch without waiting",
+			expectedProgress: []string{"Ad hoc command launched, URL: /api/v2/ad_hoc_commands/5/, Module: ping, Inventory ID: 1"},
+		},
+		{
+			name:   "wait for completion",
+			wait:   &waitTrue,
+			status: statusSuccessfulConst,
+			expectedProgress: []string{
+				"Ad hoc command launched, URL: /api/v2/ad_hoc_commands/5/, Module: ping, Inventory ID: 1",
+				"Ad hoc command at: /api/v2/ad_hoc_commands/5/ is in status: successful",
+				"Host web1: ok",
+			},
+		},
+		{
+			name:   "failed command",
+			wait:   &waitTrue,
+			status: "failed",
+			expectedProgress: []string{
+				"Ad hoc command launched, URL: /api/v2/ad_hoc_commands/5/, Module: ping, Inventory ID: 1",
+				"Ad hoc command at: /api/v2/ad_hoc_commands/5/ is in status: failed",
+				"Host web1: ok",
+			},
+			expectError: true,
+		},
+		{
+			name:             "failed command with ignored results",
+			wait:             &waitTrue,
+			ignoreJobResults: &ignoreTrue,
+			status:           "failed",
+			expectedProgress: []string{
+				"Ad hoc command launched, URL: /api/v2/ad_hoc_commands/5/, Module: ping, Inventory ID: 1",
+				"Ad hoc command at: /api/v2/ad_hoc_commands/5/ is in status: failed",
+				"Host web1: ok",
+			},
+			expectWarning: true,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockProviderHTTPClient(ctrl)
+			mockClient.EXPECT().getAPIEndpoint().Return("/api/v2")
+			mockClient.EXPECT().Create("/api/v2/ad_hoc_commands", gomock.Any()).Return(
+				[]byte(`{"url": "/api/v2/ad_hoc_commands/5/", "status": "pending", "inventory": 1, "module_name": "ping"}`),
+				diag.Diagnostics{},
+			)
+			if tc.status != "" {
+				mockClient.EXPECT().Get("/api/v2/ad_hoc_commands/5/").Return([]byte(fmt.Sprintf(`{"status": %q}`, tc.status)), diag.Diagnostics{})
+				mockClient.EXPECT().GetWithParams("/api/v2/ad_hoc_commands/5/events", gomock.Any()).Return(
+					[]byte(`{"next":null,"results":[{"counter":1,"event":"runner_on_ok","host_name":"web1"}]}`),
+					diag.Diagnostics{},
+				)
+			}
+
+			ctx := t.Context()
+			action := &AdHocCommandAction{client: mockClient}
+			schemaResp := fwaction.SchemaResponse{}
+			action.Schema(ctx, fwaction.SchemaRequest{}, &schemaResp)
+			config := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"inventory_id":                        valueOrNil(tftypes.Number, &inventoryID),
+				"limit":                               valueOrNil[string](tftypes.String, nil),
+				"module_name":                         valueOrNil(tftypes.String, &moduleName),
+				"module_args":                         valueOrNil[string](tftypes.String, nil),
+				"credential":                          valueOrNil[int64](tftypes.Number, nil),
+				"become":                              valueOrNil[bool](tftypes.Bool, nil),
+				"forks":                               valueOrNil[int64](tftypes.Number, nil),
+				"verbosity":                           valueOrNil[int64](tftypes.Number, nil),
+				"execution_environment":               valueOrNil[int64](tftypes.Number, nil),
+				"wait_for_completion":                 valueOrNil(tftypes.Bool, tc.wait),
+				"wait_for_completion_timeout_seconds": valueOrNil[int64](tftypes.Number, nil),
+				"ignore_job_results":                  valueOrNil(tftypes.Bool, tc.ignoreJobResults),
+			})
+
+			var progress []string
+			resp := &fwaction.InvokeResponse{
+				SendProgress: func(event fwaction.InvokeProgressEvent) {
+					progress = append(progress, event.Message)
+				},
+			}
+			action.Invoke(ctx, fwaction.InvokeRequest{Config: tfsdk.Config{Raw: config, Schema: schemaResp.Schema}}, resp)
+
+			if tc.expectError != resp.Diagnostics.HasError() {
+				t.Errorf("Expected error (%t), got (%v)", tc.expectError, resp.Diagnostics.Errors())
+			}
+			if tc.expectWarning != (resp.Diagnostics.WarningsCount() > 0) {
+				t.Errorf("Expected warning (%t), got (%v)", tc.expectWarning, resp.Diagnostics.Warnings())
+			}
+			if !reflect.DeepEqual(tc.expectedProgress, progress) {
+				t.Errorf("Expected progress (%v), got (%v)", tc.expectedProgress, progress)
+			}
+		})
+	}
+}
+
+func TestAccAAPAdHocCommandAction_basic(t *testing.T) {
+	randNum, _ := rand.Int(rand.Reader, big.NewInt(50000000))
+	inventoryName := fmt.Sprintf("%s-%d", "tf-acc", randNum.Int64())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdHocCommandAction(inventoryName),
+			},
+		},
+	})
+}
+
+func testAccAdHocCommandAction(inventoryName string) string {
+	return fmt.Sprintf(`
+resource "aap_inventory" "test" {
+	name = "%s"
+}
+
+resource "aap_host" "test" {
+	name         = "localhost"
+	inventory_id = aap_inventory.test.id
+	variables    = jsonencode({ "ansible_connection" : "local" })
+	lifecycle {
+		action_trigger {
+			events  = [after_create]
+			actions = [action.aap_ad_hoc_command.test]
+		}
+	}
+}
+
+action "aap_ad_hoc_command" "test" {
+	config {
+		inventory_id        = aap_inventory.test.id
+		module_name         = "ping"
+		wait_for_completion = true
+	}
+}
+`, inventoryName)
+}
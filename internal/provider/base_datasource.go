@@ -96,7 +96,7 @@ func (d *BaseDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 			},
 			"variables": schema.StringAttribute{
 				Computed:   true,
-				CustomType: customtypes.AAPCustomStringType{},
+				CustomType: customtypes.AAPCustomVariablesType{},
 				Description: fmt.Sprintf("Variables of the %s. Will be either JSON or YAML string depending on how the "+
 					"variables were entered into AAP.", d.DescriptiveEntityName),
 				DeprecationMessage: "This attribute is deprecated and will be removed in a future version.",
@@ -140,7 +140,7 @@ func (d *BaseDataSourceWithOrg) Schema(_ context.Context, _ datasource.SchemaReq
 			},
 			"variables": schema.StringAttribute{
 				Computed:   true,
-				CustomType: customtypes.AAPCustomStringType{},
+				CustomType: customtypes.AAPCustomVariablesType{},
 				Description: fmt.Sprintf("Variables of the %s. Will be either JSON or YAML string depending on how the "+
 					"variables were entered into AAP.", d.DescriptiveEntityName),
 				DeprecationMessage: "This attribute is deprecated and will be removed in a future version.",
@@ -232,7 +232,10 @@ func (d *BaseDataSourceWithOrg) ValidateConfig(ctx context.Context, req datasour
 
 	var data BaseDetailSourceModelWithOrg
 
-	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	// Read the lookup attributes only, so that data sources extending the schema can reuse this validation
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, tfpath.Root("id"), &data.ID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, tfpath.Root("name"), &data.Name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, tfpath.Root("organization_name"), &data.OrganizationName)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -355,7 +358,6 @@ func (d *BaseDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 // Read refreshes the Terraform state with the latest data.
 func (d *BaseDataSourceWithOrg) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state BaseDetailSourceModelWithOrg
-	var diags diag.Diagnostics
 
 	// Check Read preconditions
 	if !DoReadPreconditionsMeet(ctx, resp, d.client) {
@@ -364,6 +366,24 @@ func (d *BaseDataSourceWithOrg) Read(ctx context.Context, req datasource.ReadReq
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	resp.Diagnostics.Append(d.ReadNamedEntity(&state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ReadNamedEntity retrieves the AAP entity by its ID or by its name + organization_name pair, as configured
+// in the given state, and parses it into the state.
+func (d *BaseDataSourceWithOrg) ReadNamedEntity(state *BaseDetailSourceModelWithOrg) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	uri := path.Join(d.client.getAPIEndpoint(), d.APIEntitySlug)
 	resourceURL, err := state.CreateNamedURL(uri, &BaseDetailAPIModelWithOrg{
 		BaseDetailAPIModel: BaseDetailAPIModel{
@@ -378,28 +398,18 @@ func (d *BaseDataSourceWithOrg) Read(ctx context.Context, req datasource.ReadReq
 		},
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Minimal Data Not Supplied", "Expected either [id] or [name + organization_name] pair")
-		return
-	}
-
-	readResponseBody, diags := d.client.Get(resourceURL)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+		diags.AddError("Minimal Data Not Supplied", "Expected either [id] or [name + organization_name] pair")
+		return diags
 	}
 
-	diags = state.ParseHTTPResponse(readResponseBody)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	readResponseBody, getDiags := d.client.Get(resourceURL)
+	diags.Append(getDiags...)
+	if diags.HasError() {
+		return diags
 	}
 
-	// Set state
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	diags.Append(state.ParseHTTPResponse(readResponseBody)...)
+	return diags
 }
 
 // ---------------------------------------------------------------------------
@@ -424,7 +434,7 @@ func (d *BaseDetailSourceModel) ParseHTTPResponse(body []byte) diag.Diagnostics
 	d.URL = ParseStringValue(apiModel.URL)
 	d.Name = ParseStringValue(apiModel.Name)
 	d.Description = ParseStringValue(apiModel.Description)
-	d.Variables = ParseAAPCustomStringValue(apiModel.Variables)
+	d.Variables = ParseAAPCustomVariablesValue(apiModel.Variables)
 	// Parse the related fields
 	d.NamedURL = ParseStringValue(apiModel.Related.NamedURL)
 
@@ -452,7 +462,7 @@ func (d *BaseDetailSourceModelWithOrg) ParseHTTPResponse(body []byte) diag.Diagn
 	d.Name = ParseStringValue(apiModel.Name)
 	d.Description = ParseStringValue(apiModel.Description)
 	d.Organization = tftypes.Int64Value(apiModel.Organization)
-	d.Variables = ParseAAPCustomStringValue(apiModel.Variables)
+	d.Variables = ParseAAPCustomVariablesValue(apiModel.Variables)
 	// Parse the related fields
 	d.NamedURL = ParseStringValue(apiModel.Related.NamedURL)
 	// Parse the summary fields
@@ -6,7 +6,10 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"go.uber.org/mock/gomock"
 )
 
 func TestBaseDataSourceMetadata(t *testing.T) {
@@ -117,3 +120,54 @@ func TestBaseDataSourceConfigValidators(t *testing.T) {
 		})
 	}
 }
+
+func TestBaseDataSourceWithOrgReadNamedEntity(t *testing.T) {
+	var testTable = []struct {
+		name        string
+		state       BaseDetailSourceModelWithOrg
+		expectedURL string
+		expectError bool
+	}{
+		{
+			name:        "by id",
+			state:       BaseDetailSourceModelWithOrg{BaseDetailSourceModel: BaseDetailSourceModel{ID: tftypes.Int64Value(1)}},
+			expectedURL: "/api/v2/inventories/1",
+		},
+		{
+			name: "by name and organization name",
+			state: BaseDetailSourceModelWithOrg{
+				BaseDetailSourceModel: BaseDetailSourceModel{Name: tftypes.StringValue("test")},
+				OrganizationName:      tftypes.StringValue("Default"),
+			},
+			expectedURL: "/api/v2/inventories/test++Default",
+		},
+		{
+			name:        "no id nor name",
+			state:       BaseDetailSourceModelWithOrg{},
+			expectError: true,
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockProviderHTTPClient(ctrl)
+			mockClient.EXPECT().getAPIEndpoint().Return("/api/v2")
+			if test.expectedURL != "" {
+				mockClient.EXPECT().Get(test.expectedURL).Return(
+					[]byte(`{"id": 1, "name": "test", "url": "/api/v2/inventories/1/", "organization": 2}`), diag.Diagnostics{})
+			}
+
+			d := NewBaseDataSourceWithOrg(mockClient, StringDescriptions{APIEntitySlug: "inventories"})
+			diags := d.ReadNamedEntity(&test.state)
+			if test.expectError != diags.HasError() {
+				t.Fatalf("Expected error (%t), got (%v)", test.expectError, diags.Errors())
+			}
+			if !test.expectError && (test.state.ID.ValueInt64() != 1 || test.state.Organization.ValueInt64() != 2) {
+				t.Errorf("Expected the inventory 1 of the organization 2, got (%v)", test.state)
+			}
+		})
+	}
+}
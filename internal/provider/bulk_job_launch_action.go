@@ -0,0 +1,329 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// workflowJobNodesPageSize is the page size used to retrieve the nodes of a workflow job.
+const workflowJobNodesPageSize = 200
+
+// BulkJobLaunchRequestModel represents the request body of the bulk job launch endpoint.
+// POST /api/controller/v2/bulk/job_launch/
+type BulkJobLaunchRequestModel struct {
+	Name         string                `json:"name,omitempty"`
+	Organization int64                 `json:"organization,omitempty"`
+	Jobs         []BulkJobNodeAPIModel `json:"jobs"`
+}
+
+// BulkJobNodeAPIModel represents a job of the bulk job launch request.
+type BulkJobNodeAPIModel struct {
+	UnifiedJobTemplate int64          `json:"unified_job_template"`
+	Inventory          int64          `json:"inventory,omitempty"`
+	Limit              string         `json:"limit,omitempty"`
+	ExtraData          map[string]any `json:"extra_data,omitempty"`
+	Credentials        []int64        `json:"credentials,omitempty"`
+}
+
+// BulkJobLaunchAction represents an action launching several jobs at once in AAP.
+type BulkJobLaunchAction struct {
+	client ProviderHTTPClient
+}
+
+func NewBulkJobLaunchAction() action.Action {
+	return &BulkJobLaunchAction{}
+}
+
+var (
+	_ action.Action = (*BulkJobLaunchAction)(nil)
+)
+
+type BulkJobLaunchActionModel struct {
+	Name                     types.String       `tfsdk:"name"`
+	OrganizationID           types.Int64        `tfsdk:"organization_id"`
+	Jobs                     []BulkJobNodeModel `tfsdk:"jobs"`
+	WaitForCompletion        types.Bool         `tfsdk:"wait_for_completion"`
+	WaitForCompletionTimeout types.Int64        `tfsdk:"wait_for_completion_timeout_seconds"`
+	IgnoreJobResults         types.Bool         `tfsdk:"ignore_job_results"`
+}
+
+// BulkJobNodeModel maps a job of the bulk job launch action.
+type BulkJobNodeModel struct {
+	TemplateID  types.Int64                         `tfsdk:"job_template_id"`
+	InventoryID types.Int64                         `tfsdk:"inventory_id"`
+	Limit       types.String                        `tfsdk:"limit"`
+	ExtraVars   customtypes.AAPCustomVariablesValue `tfsdk:"extra_vars"`
+	Credentials types.List                          `tfsdk:"credentials"`
+}
+
+// Schema defines the schema for the bulk job launch action
+func (a *BulkJobLaunchAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the workflow job created to run the jobs.",
+			},
+			"organization_id": schema.Int64Attribute{
+				Optional: true,
+				Description: "ID of the organization of the workflow job created to run the jobs. " +
+					"Required when the user is not a superuser.",
+			},
+			"jobs": schema.ListNestedAttribute{
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				Description: "The jobs to launch.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"job_template_id": schema.Int64Attribute{
+							Required:    true,
+							Description: "ID of the job template of the job.",
+						},
+						"inventory_id": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Identifier for the inventory the job is run against.",
+						},
+						"limit": schema.StringAttribute{
+							Optional:    true,
+							Description: "Limit pattern to restrict the job run to specific hosts.",
+						},
+						"extra_vars": schema.StringAttribute{
+							Optional:    true,
+							CustomType:  customtypes.AAPCustomVariablesType{},
+							Description: "Extra Variables of the job. Must be provided as either a JSON or YAML string.",
+						},
+						"credentials": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.Int64Type,
+							Description: "List of credential IDs to use for the job run.",
+						},
+					},
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+				Description: "When this is set to `true`, Terraform will wait until all the jobs reach " +
+					"any final status, reports the status of each job and then, proceeds with the following resource operation",
+			},
+			"wait_for_completion_timeout_seconds": schema.Int64Attribute{
+				Optional: true,
+				Description: "Sets the maximum amount of seconds Terraform will wait before timing out the updates, " +
+					"and the jobs launch will fail. Default value of `120`",
+			},
+			"ignore_job_results": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "When this is set to `true`, and wait_for_completion is `true`, ignore the jobs status.",
+			},
+		},
+		MarkdownDescription: "Launches several AAP jobs at once.\n\n" +
+			"This action launches the jobs through the bulk job launch endpoint of AAP, in a single workflow job, " +
+			"such as to run the same job template against many inventories. \n" +
+			"Moreover, you can set `wait_for_completion` to true, then Terraform will " +
+			"wait until all the jobs reach any final state before continuing, and report the status " +
+			"of each job as progress. " +
+			"You can also tweak `wait_for_completion_timeout_seconds` to control the timeout limit.",
+	}
+}
+
+// CreateRequestBody creates a JSON encoded request body from the bulk job launch action data.
+func (r *BulkJobLaunchActionModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	request := BulkJobLaunchRequestModel{
+		Name:         r.Name.ValueString(),
+		Organization: r.OrganizationID.ValueInt64(),
+		Jobs:         make([]BulkJobNodeAPIModel, 0, len(r.Jobs)),
+	}
+	for i, job := range r.Jobs {
+		node := BulkJobNodeAPIModel{
+			UnifiedJobTemplate: job.TemplateID.ValueInt64(),
+			Inventory:          job.InventoryID.ValueInt64(),
+			Limit:              job.Limit.ValueString(),
+			Credentials:        ConvertListToInt64Slice(job.Credentials),
+		}
+		if IsValueProvided(job.ExtraVars) {
+			extraData, err := DecodeVariables(job.ExtraVars.ValueString())
+			if err != nil {
+				diags.AddAttributeError(
+					tfpath.Root("jobs").AtListIndex(i).AtName("extra_vars"),
+					"Invalid extra_vars",
+					fmt.Sprintf("The extra_vars of the job must be provided as either a JSON or YAML string: %s", err.Error()),
+				)
+				continue
+			}
+			node.ExtraData = extraData
+		}
+		request.Jobs = append(request.Jobs, node)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	requestBody, err := json.Marshal(request)
+	if err != nil {
+		diags.AddError("Error marshaling request body", err.Error())
+		return nil, diags
+	}
+	return requestBody, diags
+}
+
+// GetWorkflowJobNodes retrieves all the nodes of the workflow job at the given URL, filtered by the optional
+// query parameters.
+func GetWorkflowJobNodes(ctx context.Context, client ProviderHTTPClient, workflowJobURL string,
+	filters map[string]string) (WorkflowJobNodeListAPIModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var nodes WorkflowJobNodeListAPIModel
+
+	nodesURL := path.Join(workflowJobURL, "workflow_nodes")
+	for page := 1; ; page++ {
+		if !IsContextActive(ctx, "GetWorkflowJobNodes", &diags) {
+			return nodes, diags
+		}
+
+		params := map[string]string{
+			"order_by":  "id",
+			"page":      strconv.Itoa(page),
+			"page_size": strconv.Itoa(workflowJobNodesPageSize),
+		}
+		maps.Copy(params, filters)
+		body, pageDiags := client.GetWithParams(nodesURL, params)
+		diags.Append(pageDiags...)
+		if diags.HasError() {
+			return nodes, diags
+		}
+
+		var nodesPage WorkflowJobNodeListAPIModel
+		err := json.Unmarshal(body, &nodesPage)
+		if err != nil {
+			diags.AddError("Error parsing JSON response from AAP", err.Error())
+			return nodes, diags
+		}
+		nodes.Results = append(nodes.Results, nodesPage.Results...)
+
+		if nodesPage.Next == "" {
+			return nodes, diags
+		}
+	}
+}
+
+// Invoke executes the bulk job launch action.
+func (a *BulkJobLaunchAction) Invoke(ctx context.Context, req action.InvokeRequest, response *action.InvokeResponse) {
+	var config BulkJobLaunchActionModel
+
+	response.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set default timeout if not provided
+	if config.WaitForCompletionTimeout.IsNull() {
+		config.WaitForCompletionTimeout = types.Int64Value(waitForCompletionTimeoutDefault)
+	}
+
+	requestBody, diags := config.CreateRequestBody()
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := a.client.Create(path.Join(a.client.getAPIEndpoint(), "bulk", "job_launch"), bytes.NewReader(requestBody))
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var workflowJob WorkflowJobAPIModel
+	err := json.Unmarshal(body, &workflowJob)
+	if err != nil {
+		response.Diagnostics.AddError("Error parsing JSON response from AAP", err.Error())
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Bulk job launched, URL: %s, Jobs: %d", workflowJob.URL, len(config.Jobs)),
+	})
+
+	tflog.Debug(ctx, "bulk job launched", map[string]interface{}{
+		"url":    workflowJob.URL,
+		"status": workflowJob.Status,
+		"jobs":   len(config.Jobs),
+	})
+
+	if !config.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	timeout := time.Duration(config.WaitForCompletionTimeout.ValueInt64()) * time.Second
+	var status string
+	retryProgressFunc := func(status string) {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Bulk job at: %s is in status: %s", workflowJob.URL, status),
+		})
+	}
+	err = retry.RetryContext(ctx, timeout, retryUntilAAPJobReachesAnyFinalState(ctx, a.client, retryProgressFunc, workflowJob.URL, &status))
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("error when waiting for AAP bulk job to complete", err.Error()))
+		return
+	}
+
+	nodes, diags := GetWorkflowJobNodes(ctx, a.client, workflowJob.URL, nil)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	for _, node := range nodes.Results {
+		job := node.SummaryFields.Job
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Job %s (%d): %s", job.Name, job.ID, job.Status),
+		})
+	}
+
+	workflowJobResults := WorkflowJobResourceModel{
+		URL:              types.StringValue(workflowJob.URL),
+		Status:           types.StringValue(status),
+		IgnoreJobResults: config.IgnoreJobResults,
+	}
+	response.Diagnostics.Append(workflowJobResults.CheckWorkflowJobResults(ctx, a.client)...)
+}
+
+// Configure configures the bulk job launch action with the provider client
+func (a *BulkJobLaunchAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+// Metadata returns the action metadata
+func (a *BulkJobLaunchAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bulk_job_launch"
+}
@@ -0,0 +1,332 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	fwaction "github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.uber.org/mock/gomock"
+)
+
+// TestBulkJobLaunchActionSchema tests the Schema function
+func TestBulkJobLaunchActionSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	schemaResponse := fwaction.SchemaResponse{}
+	NewBulkJobLaunchAction().Schema(ctx, fwaction.SchemaRequest{}, &schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+// TestBulkJobLaunchActionMetadata tests the Metadata function
+func TestBulkJobLaunchActionMetadata(t *testing.T) {
+	t.Parallel()
+
+	metadataResponse := fwaction.MetadataResponse{}
+	NewBulkJobLaunchAction().Metadata(t.Context(), fwaction.MetadataRequest{ProviderTypeName: "aap"}, &metadataResponse)
+
+	expected := "aap_bulk_job_launch"
+	if metadataResponse.TypeName != expected {
+		t.Errorf("Expected metadata TypeName %q, received %q", expected, metadataResponse.TypeName)
+	}
+}
+
+// bulkJobNode returns a job of the bulk job launch action with the given template and inventory.
+func bulkJobNode(templateID int64, inventoryID int64) BulkJobNodeModel {
+	return BulkJobNodeModel{
+		TemplateID:  types.Int64Value(templateID),
+		InventoryID: types.Int64Value(inventoryID),
+		Limit:       types.StringNull(),
+		ExtraVars:   customtypes.NewAAPCustomVariablesNull(),
+		Credentials: types.ListNull(types.Int64Type),
+	}
+}
+
+func TestBulkJobLaunchActionCreateRequestBody(t *testing.T) {
+	fullNode := bulkJobNode(7, 2)
+	fullNode.Limit = types.StringValue("webservers")
+	fullNode.ExtraVars = customtypes.NewAAPCustomVariablesValue("release: 1.2.0\nhosts:\n  - web1\n")
+	fullNode.Credentials = types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(3), types.Int64Value(4)})
+
+	invalidNode := bulkJobNode(7, 2)
+	invalidNode.ExtraVars = customtypes.NewAAPCustomVariablesValue("release: [")
+
+	var testTable = []struct {
+		name        string
+		input       BulkJobLaunchActionModel
+		expected    []byte
+		expectError bool
+	}{
+		{
+			name: "template and inventory",
+			input: BulkJobLaunchActionModel{
+				Jobs: []BulkJobNodeModel{bulkJobNode(7, 1), bulkJobNode(7, 2)},
+			},
+			expected: []byte(`{"jobs":[{"unified_job_template":7,"inventory":1},{"unified_job_template":7,"inventory":2}]}`),
+		},
+		{
+			name: "all values",
+			input: BulkJobLaunchActionModel{
+				Name:           types.StringValue("Release 1.2.0"),
+				OrganizationID: types.Int64Value(1),
+				Jobs:           []BulkJobNodeModel{fullNode},
+			},
+			expected: []byte(`{"name":"Release 1.2.0","organization":1,"jobs":[{"unified_job_template":7,"inventory":2,"limit":"webservers",` +
+				`"extra_data":{"hosts":["web1"],"release":"1.2.0"},"credentials":[3,4]}]}`),
+		},
+		{
+			name: "invalid extra vars",
+			input: BulkJobLaunchActionModel{
+				Jobs: []BulkJobNodeModel{invalidNode},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody()
+			if test.expectError != diags.HasError() {
+				t.Fatalf("Expected error (%t), got (%v)", test.expectError, diags.Errors())
+			}
+			if string(test.expected) != string(actual) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestGetWorkflowJobNodes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	nodesURL := "/api/v2/workflow_jobs/30/workflow_nodes"
+	params := func(page string) map[string]string {
+		return map[string]string{"order_by": "id", "page": page, "page_size": "200"}
+	}
+
+	mockClient := NewMockProviderHTTPClient(ctrl)
+	gomock.InOrder(
+		mockClient.EXPECT().GetWithParams(nodesURL, params("1")).Return(
+			[]byte(`{"next": "/api/v2/workflow_jobs/30/workflow_nodes/?page=2", "results": [`+
+				`{"summary_fields": {"job": {"id": 31, "name": "Deploy", "status": "successful", "type": "job"}}}]}`),
+			diag.Diagnostics{},
+		),
+		mockClient.EXPECT().GetWithParams(nodesURL, params("2")).Return(
+			[]byte(`{"next": null, "results": [`+
+				`{"summary_fields": {"job": {"id": 32, "name": "Deploy", "status": "failed", "type": "job"}}}]}`),
+			diag.Diagnostics{},
+		),
+	)
+
+	nodes, diags := GetWorkflowJobNodes(t.Context(), mockClient, "/api/v2/workflow_jobs/30/", nil)
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+
+	var jobIDs []int64
+	for _, node := range nodes.Results {
+		jobIDs = append(jobIDs, node.SummaryFields.Job.ID)
+	}
+	if !reflect.DeepEqual([]int64{31, 32}, jobIDs) {
+		t.Errorf("Expected jobs [31 32], got %v", jobIDs)
+	}
+}
+
+// TestBulkJobLaunchActionInvoke tests the full Invoke function
+func TestBulkJobLaunchActionInvoke(t *testing.T) {
+	waitTrue := true
+	ignoreTrue := true
+
+	testTable := []struct {
+		name             string
+		wait             *bool
+		ignoreJobResults *bool
+		status           string
+		expectedProgress []string
+		expectError      bool
+		expectWarning    bool
+	}{
+		{
+			name:             "launch without waiting",
+			expectedProgress: []string{"Bulk job launched, URL: /api/v2/workflow_jobs/30/, Jobs: 2"},
+		},
+		{
+			name:   "wait for completion",
+			wait:   &waitTrue,
+			status: statusSuccessfulConst,
+			expectedProgress: []string{
+				"Bulk job launched, URL: /api/v2/workflow_jobs/30/, Jobs: 2",
+				"Bulk job at: /api/v2/workflow_jobs/30/ is in status: successful",
+				"Job Deploy (31): successful",
+				"Job Deploy (32): successful",
+			},
+		},
+		{
+			name:   "failed job",
+			wait:   &waitTrue,
+			status: "failed",
+			expectedProgress: []string{
+				"Bulk job launched, URL: /api/v2/workflow_jobs/30/, Jobs: 2",
+				"Bulk job at: /api/v2/workflow_jobs/30/ is in status: failed",
+				"Job Deploy (31): successful",
+				"Job Deploy (32): failed",
+			},
+			expectError: true,
+		},
+		{
+			name:             "failed job with ignored results",
+			wait:             &waitTrue,
+			ignoreJobResults: &ignoreTrue,
+			status:           "failed",
+			expectedProgress: []string{
+				"Bulk job launched, URL: /api/v2/workflow_jobs/30/, Jobs: 2",
+				"Bulk job at: /api/v2/workflow_jobs/30/ is in status: failed",
+				"Job Deploy (31): successful",
+				"Job Deploy (32): failed",
+			},
+			expectWarning: true,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockProviderHTTPClient(ctrl)
+			mockClient.EXPECT().getAPIEndpoint().Return("/api/v2")
+			mockClient.EXPECT().Create("/api/v2/bulk/job_launch", gomock.Any()).Return(
+				[]byte(`{"id": 30, "url": "/api/v2/workflow_jobs/30/", "status": "pending"}`),
+				diag.Diagnostics{},
+			)
+			if tc.status != "" {
+				mockClient.EXPECT().Get("/api/v2/workflow_jobs/30/").Return([]byte(fmt.Sprintf(`{"status": %q}`, tc.status)), diag.Diagnostics{})
+				mockClient.EXPECT().GetWithParams("/api/v2/workflow_jobs/30/workflow_nodes", gomock.Any()).Return(
+					[]byte(fmt.Sprintf(`{"next": null, "results": [`+
+						`{"summary_fields": {"job": {"id": 31, "name": "Deploy", "status": "successful", "type": "job"}}},`+
+						`{"summary_fields": {"job": {"id": 32, "name": "Deploy", "status": %q, "type": "job"}}}]}`, tc.status)),
+					diag.Diagnostics{},
+				)
+				if tc.status != statusSuccessfulConst {
+					mockClient.EXPECT().GetWithParams("/api/v2/workflow_jobs/30/workflow_nodes", map[string]string{
+						"job__failed": "true", "order_by": "id", "page": "1", "page_size": "200",
+					}).Return(
+						[]byte(`{"results": [{"summary_fields": {"job": {"id": 32, "name": "Deploy", "status": "failed", "type": "job"}}}]}`),
+						diag.Diagnostics{},
+					)
+					mockClient.EXPECT().getAPIEndpoint().Return("/api/v2")
+					mockClient.EXPECT().GetWithParams("/api/v2/jobs/32/job_host_summaries", gomock.Any()).Return(
+						[]byte(`{"results": [{"host_name": "web1", "failures": 1}]}`),
+						diag.Diagnostics{},
+					)
+					mockClient.EXPECT().GetWithParams("/api/v2/jobs/32/stdout", map[string]string{"format": "txt"}).Return(
+						[]byte("fatal: [web1]"),
+						diag.Diagnostics{},
+					)
+				}
+			}
+
+			ctx := t.Context()
+			action := &BulkJobLaunchAction{client: mockClient}
+			schemaResp := fwaction.SchemaResponse{}
+			action.Schema(ctx, fwaction.SchemaRequest{}, &schemaResp)
+			configType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			jobsType := configType.AttributeTypes["jobs"].(tftypes.List)
+			jobType := jobsType.ElementType.(tftypes.Object)
+			job := func(inventoryID int64) tftypes.Value {
+				return tftypes.NewValue(jobType, map[string]tftypes.Value{
+					"job_template_id": tftypes.NewValue(tftypes.Number, 7),
+					"inventory_id":    tftypes.NewValue(tftypes.Number, inventoryID),
+					"limit":           tftypes.NewValue(tftypes.String, nil),
+					"extra_vars":      tftypes.NewValue(tftypes.String, nil),
+					"credentials":     tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil),
+				})
+			}
+			config := tftypes.NewValue(configType, map[string]tftypes.Value{
+				"name":                                valueOrNil[string](tftypes.String, nil),
+				"organization_id":                     valueOrNil[int64](tftypes.Number, nil),
+				"jobs":                                tftypes.NewValue(jobsType, []tftypes.Value{job(1), job(2)}),
+				"wait_for_completion":                 valueOrNil(tftypes.Bool, tc.wait),
+				"wait_for_completion_timeout_seconds": valueOrNil[int64](tftypes.Number, nil),
+				"ignore_job_results":                  valueOrNil(tftypes.Bool, tc.ignoreJobResults),
+			})
+
+			var progress []string
+			resp := &fwaction.InvokeResponse{
+				SendProgress: func(event fwaction.InvokeProgressEvent) {
+					progress = append(progress, event.Message)
+				},
+			}
+			action.Invoke(ctx, fwaction.InvokeRequest{Config: tfsdk.Config{Raw: config, Schema: schemaResp.Schema}}, resp)
+
+			if tc.expectError != resp.Diagnostics.HasError() {
+				t.Errorf("Expected error (%t), got (%v)", tc.expectError, resp.Diagnostics.Errors())
+			}
+			if tc.expectWarning != (resp.Diagnostics.WarningsCount() > 0) {
+				t.Errorf("Expected warning (%t), got (%v)", tc.expectWarning, resp.Diagnostics.Warnings())
+			}
+			if !reflect.DeepEqual(tc.expectedProgress, progress) {
+				t.Errorf("Expected progress (%v), got (%v)", tc.expectedProgress, progress)
+			}
+		})
+	}
+}
+
+func TestAccAAPBulkJobLaunchAction_basic(t *testing.T) {
+	jobTemplateID := os.Getenv("AAP_TEST_JOB_TEMPLATE_ID")
+	inventoryPromptJobTemplateID := os.Getenv("AAP_TEST_JOB_TEMPLATE_INVENTORY_PROMPT_ID")
+	inventoryID := os.Getenv("AAP_TEST_INVENTORY_FOR_WF_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccJobResourcePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBulkJobLaunchAction(jobTemplateID, inventoryPromptJobTemplateID, inventoryID),
+			},
+		},
+	})
+}
+
+func testAccBulkJobLaunchAction(jobTemplateID string, inventoryPromptJobTemplateID string, inventoryID string) string {
+	return fmt.Sprintf(`
+resource "terraform_data" "trigger" {
+	input = "bulk-job-launch"
+	lifecycle {
+		action_trigger {
+			events  = [after_create]
+			actions = [action.aap_bulk_job_launch.test]
+		}
+	}
+}
+
+action "aap_bulk_job_launch" "test" {
+	config {
+		name = "Terraform bulk job launch"
+		jobs = [
+			{ job_template_id = %s },
+			{ job_template_id = %s, inventory_id = %s },
+		]
+		wait_for_completion = true
+	}
+}
+`, jobTemplateID, inventoryPromptJobTemplateID, inventoryID)
+}
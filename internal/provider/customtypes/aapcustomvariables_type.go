@@ -0,0 +1,78 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ = basetypes.StringTypable(&AAPCustomVariablesType{})
+)
+
+// AAPCustomVariablesType implements a custom Terraform type for the JSON or YAML variables,
+// such as the variables of inventories, groups and hosts and the extra_vars of jobs.
+type AAPCustomVariablesType struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the given type is equivalent.
+func (t AAPCustomVariablesType) Equal(o attr.Type) bool {
+	other, ok := o.(AAPCustomVariablesType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// String returns a human readable string of the type name.
+func (t AAPCustomVariablesType) String() string {
+	return "customtypes.AAPCustomVariablesType"
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t AAPCustomVariablesType) ValueFromString(_ context.Context, in basetypes.StringValue) (
+	basetypes.StringValuable, diag.Diagnostics) {
+	value := AAPCustomVariablesValue{
+		StringValue: in,
+	}
+
+	return value, nil
+}
+
+// ValueFromTerraform converts a Terraform value to a AAPCustomVariablesValue.
+func (t AAPCustomVariablesType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected error converting value from Terraform: %w", err)
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// ValueType returns an instance of the value.
+func (t AAPCustomVariablesType) ValueType(_ context.Context) attr.Value {
+	return AAPCustomVariablesValue{}
+}
+
+// Validate implements type validation. This type requires the value provided to be a String value,
+// the variables are validated by AAP.
+func (t AAPCustomVariablesType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	return AAPCustomStringType{}.Validate(ctx, in, path)
+}
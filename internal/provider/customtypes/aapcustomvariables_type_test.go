@@ -0,0 +1,59 @@
+package customtypes_test
+
+import (
+	"testing"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAAPCustomVariablesTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in          tftypes.Value
+		expectation attr.Value
+		expectedErr string
+	}{
+		"yaml": {
+			in:          tftypes.NewValue(tftypes.String, "os: Linux\n"),
+			expectation: customtypes.NewAAPCustomVariablesValue("os: Linux\n"),
+		},
+		"json": {
+			in:          tftypes.NewValue(tftypes.String, `{"hello":"world"}`),
+			expectation: customtypes.NewAAPCustomVariablesValue(`{"hello":"world"}`),
+		},
+		"unknown": {
+			in:          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expectation: customtypes.NewAAPCustomVariablesUnknown(),
+		},
+		"null": {
+			in:          tftypes.NewValue(tftypes.String, nil),
+			expectation: customtypes.NewAAPCustomVariablesNull(),
+		},
+		"wrongType": {
+			in:          tftypes.NewValue(tftypes.Number, 123),
+			expectedErr: "unexpected error converting value from Terraform: can't unmarshal tftypes.Number into *string, expected string",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := customtypes.AAPCustomVariablesType{}.ValueFromTerraform(t.Context(), testCase.in)
+			if err != nil {
+				if testCase.expectedErr != err.Error() {
+					t.Fatalf("Expected error to be %q, got %q", testCase.expectedErr, err.Error())
+				}
+				return
+			}
+			if testCase.expectedErr != "" {
+				t.Fatalf("Expected error to be %q, didn't get an error", testCase.expectedErr)
+			}
+			if !got.Equal(testCase.expectation) {
+				t.Errorf("Expected %v, got %v", testCase.expectation, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package customtypes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	_ = basetypes.StringValuable(&AAPCustomVariablesValue{})
+	_ = basetypes.StringValuableWithSemanticEquals(&AAPCustomVariablesValue{})
+)
+
+// AAPCustomVariablesValue implements a custom Terraform value for the JSON or YAML variables.
+type AAPCustomVariablesValue struct {
+	basetypes.StringValue
+}
+
+// NewAAPCustomVariablesNull creates a AAPCustomVariablesValue with a null value. Determine
+// whether the value is null via the AAPCustomVariablesValue type IsNull method.
+func NewAAPCustomVariablesNull() AAPCustomVariablesValue {
+	return AAPCustomVariablesValue{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewAAPCustomVariablesUnknown creates a AAPCustomVariablesValue with an unknown value.
+func NewAAPCustomVariablesUnknown() AAPCustomVariablesValue {
+	return AAPCustomVariablesValue{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewAAPCustomVariablesValue creates a AAPCustomVariablesValue with a known value.
+func NewAAPCustomVariablesValue(value string) AAPCustomVariablesValue {
+	return AAPCustomVariablesValue{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v AAPCustomVariablesValue) Equal(o attr.Value) bool {
+	other, ok := o.(AAPCustomVariablesValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns an instance of the type.
+func (v AAPCustomVariablesValue) Type(_ context.Context) attr.Type {
+	return AAPCustomVariablesType{}
+}
+
+func (v AAPCustomVariablesValue) String() string {
+	return "AAPCustomVariablesValue"
+}
+
+// StringSemanticEquals checks if two AAPCustomVariablesValue objects have
+// equivalent values, even if they are not equal. Both values are parsed as JSON or YAML and
+// the resulting data structures are compared, so that formatting, comments, key order and
+// anchors do not cause differences. Values that cannot be parsed are only equal when the
+// trimmed strings are.
+func (v AAPCustomVariablesValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (
+	bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(AAPCustomVariablesValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. "+
+				"Please report this to the provider developers.", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	return SemanticallyEqual(v.ValueString(), newValue.ValueString()), nil
+}
+
+// SemanticallyEqual reports whether two JSON or YAML strings hold the same data. Strings that
+// cannot be parsed are compared after trimming whitespace.
+func SemanticallyEqual(prior string, current string) bool {
+	prior = strings.TrimSpace(prior)
+	current = strings.TrimSpace(current)
+	if prior == current {
+		return true
+	}
+
+	priorData, err := decodeDocuments(prior)
+	if err != nil {
+		return false
+	}
+	currentData, err := decodeDocuments(current)
+	if err != nil {
+		return false
+	}
+
+	return dataEqual(priorData, currentData)
+}
+
+// decodeDocuments decodes a JSON or YAML string into its documents. A JSON string is decoded
+// as a single document, a YAML string may hold several documents separated by "---".
+// An empty document, which AAP renders for empty variables, is decoded as an empty map,
+// while an explicit null is kept, so that it is not equal to an empty map.
+func decodeDocuments(value string) ([]any, error) {
+	var document any
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err == nil {
+		// Only a single JSON value is accepted, anything else is parsed as YAML
+		if _, err := decoder.Token(); errors.Is(err, io.EOF) {
+			return []any{document}, nil
+		}
+	}
+
+	var documents []any
+	yamlDecoder := yaml.NewDecoder(bytes.NewReader([]byte(value)))
+	for {
+		var node yaml.Node
+		err := yamlDecoder.Decode(&node)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if isEmptyDocument(&node) {
+			documents = append(documents, map[string]any{})
+			continue
+		}
+		var document any
+		if err := node.Decode(&document); err != nil {
+			return nil, err
+		}
+		documents = append(documents, document)
+	}
+	if len(documents) == 0 {
+		documents = append(documents, map[string]any{})
+	}
+
+	return documents, nil
+}
+
+// isEmptyDocument reports whether a YAML document has no content, such as "---" which AAP renders
+// for empty variables. A document holding an explicit null, such as "null" or "~", is not empty.
+func isEmptyDocument(node *yaml.Node) bool {
+	if node.Kind != yaml.DocumentNode || len(node.Content) > 1 {
+		return false
+	}
+	if len(node.Content) == 0 {
+		return true
+	}
+	content := node.Content[0]
+	return content.Kind == yaml.ScalarNode && content.Tag == "!!null" && content.Value == ""
+}
+
+// dataEqual reports whether two decoded JSON or YAML values are equal. Numbers are compared by
+// value, so that 1 and 1.0 are equal, while numbers, strings and booleans are never equal to
+// each other.
+func dataEqual(a, b any) bool {
+	switch a := a.(type) {
+	case []any:
+		b, ok := b.([]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !dataEqual(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for key, value := range a {
+			other, ok := b[key]
+			if !ok || !dataEqual(value, other) {
+				return false
+			}
+		}
+		return true
+	case map[any]any:
+		b, ok := b.(map[any]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for key, value := range a {
+			other, ok := b[key]
+			if !ok || !dataEqual(value, other) {
+				return false
+			}
+		}
+		return true
+	}
+
+	aNumber, aIsNumber := toNumber(a)
+	bNumber, bIsNumber := toNumber(b)
+	if aIsNumber || bIsNumber {
+		return aIsNumber && bIsNumber && aNumber.Cmp(bNumber) == 0
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// toNumber converts a decoded JSON or YAML number to a big.Rat. Infinite and NaN values,
+// which YAML allows, are not converted and compared as is.
+func toNumber(value any) (*big.Rat, bool) {
+	switch value := value.(type) {
+	case json.Number:
+		return new(big.Rat).SetString(value.String())
+	case int:
+		return new(big.Rat).SetInt64(int64(value)), true
+	case int64:
+		return new(big.Rat).SetInt64(value), true
+	case uint64:
+		return new(big.Rat).SetUint64(value), true
+	case float64:
+		number := new(big.Rat).SetFloat64(value)
+		return number, number != nil
+	}
+	return nil, false
+}
@@ -0,0 +1,187 @@
+package customtypes_test
+
+import (
+	"testing"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestAAPCustomVariablesStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentData   customtypes.AAPCustomVariablesValue
+		givenData     basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"not equal - mismatched field values": {
+			currentData:   customtypes.NewAAPCustomVariablesValue(`"{"exampleVariables":[{"name":"bar","namespace":"bar-namespace","type":0}]}"`),
+			givenData:     customtypes.NewAAPCustomVariablesValue(`{"exampleVariables":[{"name":"bar","namespace":"bar-namespace","type":1}]}`),
+			expectedMatch: false,
+		},
+		"not equal - mismatched field names": {
+			currentData:   customtypes.NewAAPCustomVariablesValue(`{"exampleVariables":[{"Name":"bar","namespace":"bar-namespace","type":0}]}`),
+			givenData:     customtypes.NewAAPCustomVariablesValue(`{"exampleVariables":[{"name":"bar","namespace":"bar-namespace","type":0}]}`),
+			expectedMatch: false,
+		},
+		"not equal - additional field": {
+			currentData:   customtypes.NewAAPCustomVariablesValue(`{"exampleVariables":[{"name":"bar","namespace":"bar-namespace","type":0}],"new-field": null}`),
+			givenData:     customtypes.NewAAPCustomVariablesValue(`{"exampleVariables":[{"name":"bar","namespace":"bar-namespace","type":0}]}`),
+			expectedMatch: false,
+		},
+		"not equal - array item order difference": {
+			currentData:   customtypes.NewAAPCustomVariablesValue(`{"nums":[1,2,3]}`),
+			givenData:     customtypes.NewAAPCustomVariablesValue(`{"nums":[3,2,1]}`),
+			expectedMatch: false,
+		},
+		"semantically equal - object key order difference": {
+			currentData:   customtypes.NewAAPCustomVariablesValue(`{"exampleVariables":[{"namespace":"bar-namespace","name":"bar","type":0}]}`),
+			givenData:     customtypes.NewAAPCustomVariablesValue(`{"exampleVariables":[{"name":"bar","namespace":"bar-namespace","type":0}]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - object byte-for-byte match": {
+			currentData:   customtypes.NewAAPCustomVariablesValue(`{"exampleVariables":[{"name":"bar","namespace":"bar-namespace","type":0}]}`),
+			givenData:     customtypes.NewAAPCustomVariablesValue(`{"exampleVariables":[{"name":"bar","namespace":"bar-namespace","type":0}]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - object whitespace difference": {
+			currentData: customtypes.NewAAPCustomVariablesValue(`{
+				"hello": "world",
+				"nums": [1, 2, 3],
+				"nested": {
+					"test-bool": true
+				}
+			}`),
+			givenData:     customtypes.NewAAPCustomVariablesValue(`{"hello":"world","nums":[1,2,3],"nested":{"test-bool":true}}`),
+			expectedMatch: true,
+		},
+		"semantically equal - json and yaml": {
+			currentData:   customtypes.NewAAPCustomVariablesValue(`{"os":"Linux","packages":["git","vim"]}`),
+			givenData:     customtypes.NewAAPCustomVariablesValue("---\nos: Linux\npackages:\n  - git\n  - vim\n"),
+			expectedMatch: true,
+		},
+		"semantically equal - yaml reformatted": {
+			currentData:   customtypes.NewAAPCustomVariablesValue("os: Linux\npackages: [git, vim]"),
+			givenData:     customtypes.NewAAPCustomVariablesValue("packages:\n- git\n- vim\nos: 'Linux'\n"),
+			expectedMatch: true,
+		},
+		"semantically equal - yaml comments": {
+			currentData:   customtypes.NewAAPCustomVariablesValue("# Managed by Terraform\nos: Linux # operating system\n"),
+			givenData:     customtypes.NewAAPCustomVariablesValue("os: Linux"),
+			expectedMatch: true,
+		},
+		"semantically equal - yaml anchors and merge keys": {
+			currentData: customtypes.NewAAPCustomVariablesValue("base: &base\n  user: admin\n  port: 22\n" +
+				"web:\n  <<: *base\n  port: 80\nusers: [&u admin, *u]\n"),
+			givenData: customtypes.NewAAPCustomVariablesValue(`{"base":{"user":"admin","port":22},` +
+				`"web":{"user":"admin","port":80},"users":["admin","admin"]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - empty json object and empty yaml document": {
+			currentData:   customtypes.NewAAPCustomVariablesValue("{}"),
+			givenData:     customtypes.NewAAPCustomVariablesValue("---"),
+			expectedMatch: true,
+		},
+		"not equal - empty json object and yaml null": {
+			currentData:   customtypes.NewAAPCustomVariablesValue("{}"),
+			givenData:     customtypes.NewAAPCustomVariablesValue("--- ~"),
+			expectedMatch: false,
+		},
+		"not equal - empty json object and null": {
+			currentData:   customtypes.NewAAPCustomVariablesValue("{}"),
+			givenData:     customtypes.NewAAPCustomVariablesValue("null"),
+			expectedMatch: false,
+		},
+		"semantically equal - empty json object and empty yaml flow mapping": {
+			currentData:   customtypes.NewAAPCustomVariablesValue("{}"),
+			givenData:     customtypes.NewAAPCustomVariablesValue("--- {}\n"),
+			expectedMatch: true,
+		},
+		"semantically equal - yaml multi-document": {
+			currentData:   customtypes.NewAAPCustomVariablesValue("---\nfoo: 1\n---\nbar: 2\n"),
+			givenData:     customtypes.NewAAPCustomVariablesValue("foo: 1\n---\nbar: 2"),
+			expectedMatch: true,
+		},
+		"not equal - yaml multi-document with missing document": {
+			currentData:   customtypes.NewAAPCustomVariablesValue("foo: 1\n---\nbar: 2\n"),
+			givenData:     customtypes.NewAAPCustomVariablesValue("foo: 1"),
+			expectedMatch: false,
+		},
+		"semantically equal - integer and float": {
+			currentData:   customtypes.NewAAPCustomVariablesValue(`{"port":80,"ratio":0.5}`),
+			givenData:     customtypes.NewAAPCustomVariablesValue("port: 80.0\nratio: 5e-1\n"),
+			expectedMatch: true,
+		},
+		"semantically equal - large integers": {
+			currentData:   customtypes.NewAAPCustomVariablesValue(`{"id":18446744073709551615}`),
+			givenData:     customtypes.NewAAPCustomVariablesValue("id: 18446744073709551615"),
+			expectedMatch: true,
+		},
+		"not equal - large integers differ": {
+			currentData:   customtypes.NewAAPCustomVariablesValue(`{"id":9007199254740993}`),
+			givenData:     customtypes.NewAAPCustomVariablesValue(`{"id":9007199254740992}`),
+			expectedMatch: false,
+		},
+		"not equal - number and string": {
+			currentData:   customtypes.NewAAPCustomVariablesValue(`{"port":80}`),
+			givenData:     customtypes.NewAAPCustomVariablesValue(`port: "80"`),
+			expectedMatch: false,
+		},
+		"not equal - boolean and string": {
+			currentData:   customtypes.NewAAPCustomVariablesValue(`{"enabled":true}`),
+			givenData:     customtypes.NewAAPCustomVariablesValue(`enabled: "true"`),
+			expectedMatch: false,
+		},
+		"not equal - yaml 1.1 boolean": {
+			currentData:   customtypes.NewAAPCustomVariablesValue(`{"enabled":true}`),
+			givenData:     customtypes.NewAAPCustomVariablesValue("enabled: yes"),
+			expectedMatch: false,
+		},
+		"not equal - null and empty string": {
+			currentData:   customtypes.NewAAPCustomVariablesValue(`{"value":null}`),
+			givenData:     customtypes.NewAAPCustomVariablesValue(`value: ""`),
+			expectedMatch: false,
+		},
+		"not equal - invalid yaml": {
+			currentData:   customtypes.NewAAPCustomVariablesValue("foo: [bar"),
+			givenData:     customtypes.NewAAPCustomVariablesValue("foo: [bar]"),
+			expectedMatch: false,
+		},
+		"semantically equal - yaml no difference": {
+			currentData: customtypes.NewAAPCustomVariablesValue(`os: Linux
+			automation: ansible-devel`),
+			givenData: customtypes.NewAAPCustomVariablesValue(`os: Linux
+			automation: ansible-devel`),
+			expectedMatch: true,
+		},
+		"semantically equal - yaml no difference with newline": {
+			currentData: customtypes.NewAAPCustomVariablesValue(`os: Linux
+			automation: ansible-devel`),
+			givenData: customtypes.NewAAPCustomVariablesValue(`os: Linux
+			automation: ansible-devel
+
+			`),
+			expectedMatch: true,
+		},
+	}
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentData.StringSemanticEquals(t.Context(), testCase.givenData)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,394 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EDAEventStreamAPIModel represents the EDA API model for an event stream.
+// /api/eda/v1/event-streams/<id>/
+type EDAEventStreamAPIModel struct {
+	ID              int64                      `json:"id,omitempty"`
+	Name            string                     `json:"name"`
+	OrganizationID  int64                      `json:"organization_id,omitempty"`
+	EdaCredentialID int64                      `json:"eda_credential_id,omitempty"`
+	EventStreamType string                     `json:"event_stream_type,omitempty"`
+	TestMode        bool                       `json:"test_mode"`
+	URL             string                     `json:"url,omitempty"`
+	Organization    *EDAEventStreamRelatedItem `json:"organization,omitempty"`
+	EdaCredential   *EDAEventStreamRelatedItem `json:"eda_credential,omitempty"`
+}
+
+// EDAEventStreamRelatedItem represents a nested object reference in EDA API responses.
+type EDAEventStreamRelatedItem struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// EDAEventStreamResourceModel maps the event stream resource schema to a Go struct.
+type EDAEventStreamResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	OrganizationID  types.Int64  `tfsdk:"organization_id"`
+	EdaCredentialID types.Int64  `tfsdk:"eda_credential_id"`
+	EventStreamType types.String `tfsdk:"event_stream_type"`
+	TestMode        types.Bool   `tfsdk:"test_mode"`
+	URL             types.String `tfsdk:"url"`
+}
+
+// EDAEventStreamResource is the resource implementation.
+type EDAEventStreamResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &EDAEventStreamResource{}
+	_ resource.ResourceWithConfigure = &EDAEventStreamResource{}
+)
+
+// NewEDAEventStreamResource is a helper function to simplify the provider implementation.
+func NewEDAEventStreamResource() resource.Resource {
+	return &EDAEventStreamResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *EDAEventStreamResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_eda_event_stream"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *EDAEventStreamResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the event stream resource.
+func (r *EDAEventStreamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "ID of the EDA Event Stream",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the EDA Event Stream",
+			},
+			"organization_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Identifier for the organization the EDA Event Stream belongs to.",
+			},
+			"eda_credential_id": schema.Int64Attribute{
+				Required: true,
+				Description: "Identifier for the EDA credential used to authenticate the events posted to the " +
+					"EDA Event Stream. The credential type determines the event stream type.",
+			},
+			"event_stream_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Type of the EDA Event Stream, for example `hmac` or `basic`. " +
+					"If not provided, it is derived by EDA from the credential type.",
+			},
+			"test_mode": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "When this is set to `true`, the events received by the EDA Event Stream are not forwarded " +
+					"to the rulebook activations, they are only displayed in the EDA Event Stream details. " +
+					"The EDA API has no separate setting to forward events: they are forwarded whenever `test_mode` is `false`.",
+			},
+			"url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "URL to receive the events POST. It can be used as the `event_stream_config.url` " +
+					"of the `aap_eda_eventstream_post` action.",
+			},
+		},
+		Description: `Creates an EDA Event Stream.`,
+	}
+}
+
+// Create creates the event stream resource and sets the Terraform state on success.
+func (r *EDAEventStreamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EDAEventStreamResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into event stream resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eventStreamsURL, diags := r.eventStreamsURL()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from event stream data
+	createRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestData := bytes.NewReader(createRequestBody)
+
+	// Create new event stream in EDA
+	createResponseBody, diags := r.client.Create(eventStreamsURL, requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save new event stream data into event stream resource model
+	diags = data.ParseHTTPResponse(createResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest event stream data.
+func (r *EDAEventStreamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EDAEventStreamResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into event stream resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eventStreamURL, diags := r.eventStreamURL(data.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get latest event stream data from EDA
+	readResponseBody, diags, status := r.client.GetWithStatus(eventStreamURL, nil)
+
+	// Check if the response is 404, meaning the event stream does not exist and should be recreated
+	if status == http.StatusNotFound {
+		resp.Diagnostics.AddWarning(
+			"EDA Event Stream not found",
+			"The EDA Event Stream was not found. It may have been deleted. The EDA Event Stream will be recreated.",
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save latest event stream data into event stream resource model
+	diags = data.ParseHTTPResponse(readResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the event stream resource and sets the updated Terraform state on success.
+func (r *EDAEventStreamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data EDAEventStreamResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into event stream resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eventStreamURL, diags := r.eventStreamURL(data.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from event stream data
+	updateRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestData := bytes.NewReader(updateRequestBody)
+
+	// EDA only supports partial updates of event streams
+	updateResponse, updateResponseBody, err := r.client.doRequest(http.MethodPatch, eventStreamURL, nil, requestData)
+	resp.Diagnostics.Append(ValidateResponse(updateResponse, updateResponseBody, err, []int{http.StatusOK})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated event stream data into event stream resource model
+	diags = data.ParseHTTPResponse(updateResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the event stream resource.
+func (r *EDAEventStreamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data EDAEventStreamResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into event stream resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eventStreamURL, diags := r.eventStreamURL(data.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete event stream from EDA
+	_, diags = r.client.Delete(eventStreamURL)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// eventStreamsURL returns the path of the EDA event streams collection.
+func (r *EDAEventStreamResource) eventStreamsURL() (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	edaEndpoint := r.client.getEdaAPIEndpoint()
+	if edaEndpoint == "" {
+		diags.AddError(
+			"EDA API Endpoint is empty",
+			"Expected a valid endpoint but was an empty string. Please report this issue to the provider developers.",
+		)
+		return "", diags
+	}
+	return path.Join(edaEndpoint, "event-streams"), diags
+}
+
+// eventStreamURL returns the path of a single EDA event stream. The `url` attribute holds the
+// receiver URL of the event stream, so the API path is built from the event stream ID.
+func (r *EDAEventStreamResource) eventStreamURL(id types.Int64) (string, diag.Diagnostics) {
+	eventStreamsURL, diags := r.eventStreamsURL()
+	if diags.HasError() {
+		return "", diags
+	}
+	return path.Join(eventStreamsURL, strconv.FormatInt(id.ValueInt64(), 10)), diags
+}
+
+// CreateRequestBody creates a JSON encoded request body from the event stream resource data.
+func (r *EDAEventStreamResourceModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+	// Convert event stream resource data to API data model
+	eventStream := EDAEventStreamAPIModel{
+		Name:            r.Name.ValueString(),
+		OrganizationID:  r.OrganizationID.ValueInt64(),
+		EdaCredentialID: r.EdaCredentialID.ValueInt64(),
+		EventStreamType: r.EventStreamType.ValueString(),
+		TestMode:        r.TestMode.ValueBool(),
+	}
+
+	// Create JSON encoded request body
+	jsonBody, err := json.Marshal(eventStream)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for EDA event stream resource, unexpected error: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	return jsonBody, nil
+}
+
+// ParseHTTPResponse updates the event stream resource data from an EDA API response.
+func (r *EDAEventStreamResourceModel) ParseHTTPResponse(body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unmarshal the JSON response
+	var resultAPIEventStream EDAEventStreamAPIModel
+	err := json.Unmarshal(body, &resultAPIEventStream)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	// EDA returns the organization and credential as nested objects on reads,
+	// but older versions only return their identifiers.
+	organizationID := resultAPIEventStream.OrganizationID
+	if resultAPIEventStream.Organization != nil {
+		organizationID = resultAPIEventStream.Organization.ID
+	}
+	edaCredentialID := resultAPIEventStream.EdaCredentialID
+	if resultAPIEventStream.EdaCredential != nil {
+		edaCredentialID = resultAPIEventStream.EdaCredential.ID
+	}
+
+	// Map response to the event stream resource schema and update attribute values
+	r.ID = types.Int64Value(resultAPIEventStream.ID)
+	r.Name = types.StringValue(resultAPIEventStream.Name)
+	r.OrganizationID = types.Int64Value(organizationID)
+	r.EdaCredentialID = types.Int64Value(edaCredentialID)
+	r.EventStreamType = ParseStringValue(resultAPIEventStream.EventStreamType)
+	r.TestMode = types.BoolValue(resultAPIEventStream.TestMode)
+	r.URL = ParseStringValue(resultAPIEventStream.URL)
+
+	return diags
+}
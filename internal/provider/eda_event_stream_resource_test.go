@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"go.uber.org/mock/gomock"
+)
+
+const resourceNameEDAEventStream = "aap_eda_event_stream.test"
+
+func TestEDAEventStreamResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the EDAEventStreamResource and call its Schema method
+	NewEDAEventStreamResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestEDAEventStreamResourceCreateRequestBody(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    EDAEventStreamResourceModel
+		expected []byte
+	}{
+		{
+			name: "null values",
+			input: EDAEventStreamResourceModel{
+				ID:              tftypes.Int64Null(),
+				Name:            tftypes.StringNull(),
+				OrganizationID:  tftypes.Int64Null(),
+				EdaCredentialID: tftypes.Int64Null(),
+				EventStreamType: tftypes.StringNull(),
+				TestMode:        tftypes.BoolNull(),
+				URL:             tftypes.StringNull(),
+			},
+			expected: []byte(`{"name":"","test_mode":false}`),
+		},
+		{
+			name: "unknown computed values",
+			input: EDAEventStreamResourceModel{
+				ID:              tftypes.Int64Unknown(),
+				Name:            tftypes.StringValue("test stream"),
+				OrganizationID:  tftypes.Int64Value(1),
+				EdaCredentialID: tftypes.Int64Value(2),
+				EventStreamType: tftypes.StringUnknown(),
+				TestMode:        tftypes.BoolValue(false),
+				URL:             tftypes.StringUnknown(),
+			},
+			expected: []byte(`{"name":"test stream","organization_id":1,"eda_credential_id":2,"test_mode":false}`),
+		},
+		{
+			name: "provided values",
+			input: EDAEventStreamResourceModel{
+				ID:              tftypes.Int64Value(3),
+				Name:            tftypes.StringValue("test stream"),
+				OrganizationID:  tftypes.Int64Value(1),
+				EdaCredentialID: tftypes.Int64Value(2),
+				EventStreamType: tftypes.StringValue("basic"),
+				TestMode:        tftypes.BoolValue(true),
+				URL:             tftypes.StringValue("https://aap.example.com/eda-event-streams/api/eda/v1/external_event_stream/abc/post/"),
+			},
+			expected: []byte(`{"name":"test stream","organization_id":1,"eda_credential_id":2,"event_stream_type":"basic","test_mode":true}`),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody()
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			if !bytes.Equal(test.expected, actual) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestEDAEventStreamResourceParseHTTPResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected EDAEventStreamResourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: EDAEventStreamResourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name:  "flat identifiers",
+			input: []byte(`{"id":3,"name":"test stream","organization_id":1,"eda_credential_id":2,"test_mode":true}`),
+			expected: EDAEventStreamResourceModel{
+				ID:              tftypes.Int64Value(3),
+				Name:            tftypes.StringValue("test stream"),
+				OrganizationID:  tftypes.Int64Value(1),
+				EdaCredentialID: tftypes.Int64Value(2),
+				EventStreamType: tftypes.StringNull(),
+				TestMode:        tftypes.BoolValue(true),
+				URL:             tftypes.StringNull(),
+			},
+			errors: diag.Diagnostics{},
+		},
+		{
+			name: "nested objects",
+			input: []byte(
+				`{"id":3,"name":"test stream","organization":{"id":1,"name":"Default"},` +
+					`"eda_credential":{"id":2,"name":"Test Credential"},"event_stream_type":"basic","test_mode":false,` +
+					`"url":"https://aap.example.com/eda-event-streams/api/eda/v1/external_event_stream/abc/post/"}`,
+			),
+			expected: EDAEventStreamResourceModel{
+				ID:              tftypes.Int64Value(3),
+				Name:            tftypes.StringValue("test stream"),
+				OrganizationID:  tftypes.Int64Value(1),
+				EdaCredentialID: tftypes.Int64Value(2),
+				EventStreamType: tftypes.StringValue("basic"),
+				TestMode:        tftypes.BoolValue(false),
+				URL:             tftypes.StringValue("https://aap.example.com/eda-event-streams/api/eda/v1/external_event_stream/abc/post/"),
+			},
+			errors: diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := EDAEventStreamResourceModel{}
+			diags := resource.ParseHTTPResponse(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if test.expected != resource {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, resource)
+			}
+		})
+	}
+}
+
+func TestEDAEventStreamResourceURLs(t *testing.T) {
+	t.Run("builds event stream paths from the EDA endpoint", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := NewMockProviderHTTPClient(ctrl)
+		mockClient.EXPECT().getEdaAPIEndpoint().Return("/api/eda/v1").Times(1)
+
+		r := EDAEventStreamResource{client: mockClient}
+		url, diags := r.eventStreamURL(tftypes.Int64Value(3))
+		if diags.HasError() {
+			t.Fatal(diags.Errors())
+		}
+		if url != "/api/eda/v1/event-streams/3" {
+			t.Errorf("Expected (/api/eda/v1/event-streams/3) not equal to actual (%s)", url)
+		}
+	})
+
+	t.Run("fails when the EDA endpoint is empty", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := NewMockProviderHTTPClient(ctrl)
+		mockClient.EXPECT().getEdaAPIEndpoint().Return("").Times(1)
+
+		r := EDAEventStreamResource{client: mockClient}
+		_, diags := r.eventStreamsURL()
+		if !diags.HasError() {
+			t.Fatal("Expected an error when the EDA API endpoint is empty")
+		}
+		if diags.Errors()[0].Summary() != "EDA API Endpoint is empty" {
+			t.Errorf("Unexpected error summary: %s", diags.Errors()[0].Summary())
+		}
+	})
+}
+
+func TestAccEDAEventStreamResource(t *testing.T) {
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	updatedName := "updated " + randomName
+	credentialID := os.Getenv("AAP_TEST_EDA_CREDENTIAL_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipTestWithoutEDAPreCheck(t)
+			if credentialID == "" {
+				t.Skip("AAP_TEST_EDA_CREDENTIAL_ID is not set: skipping test")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEDAEventStreamResource(randomName, credentialID, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameEDAEventStream, "name", randomName),
+					resource.TestCheckResourceAttr(resourceNameEDAEventStream, "eda_credential_id", credentialID),
+					resource.TestCheckResourceAttr(resourceNameEDAEventStream, "test_mode", "false"),
+					resource.TestCheckResourceAttrSet(resourceNameEDAEventStream, "event_stream_type"),
+					resource.TestMatchResourceAttr(resourceNameEDAEventStream, "url", reEventStreamPostURL),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccEDAEventStreamResource(updatedName, credentialID, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameEDAEventStream, "name", updatedName),
+					resource.TestCheckResourceAttr(resourceNameEDAEventStream, "test_mode", "true"),
+					resource.TestMatchResourceAttr(resourceNameEDAEventStream, "url", reEventStreamPostURL),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckEDAEventStreamResourceDestroy,
+	})
+}
+
+// testAccEDAEventStreamResource returns a configuration for an EDA Event Stream in the Default organization.
+func testAccEDAEventStreamResource(name string, credentialID string, testMode bool) string {
+	return fmt.Sprintf(`
+resource "aap_eda_event_stream" "test" {
+  name              = "%s"
+  organization_id   = 1
+  eda_credential_id = %s
+  test_mode         = %t
+}`, name, credentialID, testMode)
+}
+
+// testAccCheckEDAEventStreamResourceDestroy verifies the event stream has been destroyed.
+func testAccCheckEDAEventStreamResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aap_eda_event_stream" {
+			continue
+		}
+
+		_, err := testGetResource(fmt.Sprintf("/api/eda/v1/event-streams/%s/", rs.Primary.Attributes["id"]))
+		if err == nil {
+			return fmt.Errorf("event stream (%s) still exists", rs.Primary.Attributes["id"])
+		}
+
+		if !strings.Contains(err.Error(), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
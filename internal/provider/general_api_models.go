@@ -55,12 +55,12 @@ type BaseEdaAPIModelList struct {
 
 // BaseDetailSourceModel represents the Terraform data source model for base detail resources.
 type BaseDetailSourceModel struct {
-	ID          tftypes.Int64                    `tfsdk:"id"`
-	URL         tftypes.String                   `tfsdk:"url"`
-	Description tftypes.String                   `tfsdk:"description"`
-	Name        tftypes.String                   `tfsdk:"name"`
-	NamedURL    tftypes.String                   `tfsdk:"named_url"`
-	Variables   customtypes.AAPCustomStringValue `tfsdk:"variables"`
+	ID          tftypes.Int64                       `tfsdk:"id"`
+	URL         tftypes.String                      `tfsdk:"url"`
+	Description tftypes.String                      `tfsdk:"description"`
+	Name        tftypes.String                      `tfsdk:"name"`
+	NamedURL    tftypes.String                      `tfsdk:"named_url"`
+	Variables   customtypes.AAPCustomVariablesValue `tfsdk:"variables"`
 }
 
 // BaseDetailSourceModelWithOrg represents the Terraform data source model with organization information.
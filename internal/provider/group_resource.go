@@ -5,18 +5,29 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// groupAssociationsPageSize is the page size used to retrieve the children and hosts of a group.
+const groupAssociationsPageSize = 200
+
 // GroupAPIModel represents a Group AAP API model
 type GroupAPIModel struct {
 	InventoryID int64  `json:"inventory"`
@@ -29,12 +40,19 @@ type GroupAPIModel struct {
 
 // GroupResourceModel maps the group resource schema to a Go struct
 type GroupResourceModel struct {
-	InventoryID types.Int64                      `tfsdk:"inventory_id"`
-	Name        types.String                     `tfsdk:"name"`
-	Description types.String                     `tfsdk:"description"`
-	URL         types.String                     `tfsdk:"url"`
-	Variables   customtypes.AAPCustomStringValue `tfsdk:"variables"`
-	ID          types.Int64                      `tfsdk:"id"`
+	InventoryID               types.Int64                         `tfsdk:"inventory_id"`
+	Name                      types.String                        `tfsdk:"name"`
+	Description               types.String                        `tfsdk:"description"`
+	URL                       types.String                        `tfsdk:"url"`
+	Variables                 customtypes.AAPCustomVariablesValue `tfsdk:"variables"`
+	VariablesMap              types.Dynamic                       `tfsdk:"variables_map"`
+	VariablesManagedKeys      types.Bool                          `tfsdk:"variables_managed_keys"`
+	SensitiveVariables        types.Map                           `tfsdk:"sensitive_variables"`
+	SensitiveVariablesVersion types.Int64                         `tfsdk:"sensitive_variables_version"`
+	SensitiveVariablesKeys    types.Set                           `tfsdk:"sensitive_variables_keys"`
+	ID                        types.Int64                         `tfsdk:"id"`
+	Children                  types.Set                           `tfsdk:"children"`
+	Hosts                     types.Set                           `tfsdk:"hosts"`
 }
 
 // GroupResource is the resource implementation.
@@ -44,8 +62,9 @@ type GroupResource struct {
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &GroupResource{}
-	_ resource.ResourceWithConfigure = &GroupResource{}
+	_ resource.Resource                     = &GroupResource{}
+	_ resource.ResourceWithConfigure        = &GroupResource{}
+	_ resource.ResourceWithConfigValidators = &GroupResource{}
 )
 
 // NewGroupResource is a helper function to simplify the provider implementation.
@@ -108,15 +127,40 @@ func (r *GroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "Group Id",
 			},
 			"variables": schema.StringAttribute{
-				Description: "Variables for the group configuration. Must be provided as either a JSON or YAML string.",
+				Description: "Variables for the group configuration. Must be provided as either a JSON or YAML string. " +
+					"Conflicts with `variables_map`.",
+				Optional:   true,
+				CustomType: customtypes.AAPCustomVariablesType{},
+			},
+			"variables_map":               variablesMapAttribute("group"),
+			"variables_managed_keys":      variablesManagedKeysAttribute("group"),
+			"sensitive_variables":         sensitiveVariablesAttribute("group"),
+			"sensitive_variables_version": sensitiveVariablesVersionAttribute(),
+			"sensitive_variables_keys":    sensitiveVariablesKeysAttribute(),
+			"children": schema.SetAttribute{
+				ElementType: types.Int64Type,
 				Optional:    true,
-				CustomType:  customtypes.AAPCustomStringType{},
+				Validators:  []validator.Set{setvalidator.ValueInt64sAre(int64validator.AtLeast(1))},
+				Description: "IDs of the child groups of the group. When not set, the child groups are not managed. " +
+					"A child group can not be the group itself or one of its ancestors.",
+			},
+			"hosts": schema.SetAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators:  []validator.Set{setvalidator.ValueInt64sAre(int64validator.AtLeast(1))},
+				Description: "IDs of the hosts that are direct members of the group. When not set, the hosts are not managed. " +
+					"Do not manage the same membership with both this attribute and the `groups` attribute of `aap_host`.",
 			},
 		},
 		Description: `Creates an inventory group.`,
 	}
 }
 
+// ConfigValidators returns the configuration validators of the resource.
+func (r *GroupResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return variablesConfigValidators()
+}
+
 // Create creates the group resource and sets the Terraform state on success.
 func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data GroupResourceModel
@@ -128,6 +172,16 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// WriteOnly attributes (sensitive_variables) must be read from the config,
+	// not the plan, because WriteOnly values are always null in the plan.
+	var configData GroupResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.SensitiveVariables = configData.SensitiveVariables
+	data.SensitiveVariablesKeys = SensitiveVariablesKeys(data.SensitiveVariables)
+
 	// Create request body from group data
 	createRequestBody, diags := data.CreateRequestBody()
 	resp.Diagnostics.Append(diags...)
@@ -152,6 +206,16 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// Associate the child groups and hosts of the group
+	resp.Diagnostics.Append(r.HandleGroupMembership(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.ReadGroupMembership(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -184,6 +248,11 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	resp.Diagnostics.Append(r.ReadGroupMembership(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -202,8 +271,35 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// WriteOnly attributes (sensitive_variables) must be read from the config,
+	// not the plan, because WriteOnly values are always null in the plan.
+	var configData GroupResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.SensitiveVariables = configData.SensitiveVariables
+	data.SensitiveVariablesKeys = SensitiveVariablesKeys(data.SensitiveVariables)
+
+	// Only update the managed keys of the variables
+	requestModel := data
+	if data.VariablesManagedKeys.ValueBool() {
+		var state GroupResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		requestModel.Variables, diags = MergeManagedVariables(r.client, data.URL.ValueString(),
+			state.Variables, state.VariablesMap, state.SensitiveVariablesKeys, data.Variables, data.VariablesMap)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		requestModel.VariablesMap = types.DynamicNull()
+	}
+
 	// Create request body from group data
-	updateRequestBody, diags := data.CreateRequestBody()
+	updateRequestBody, diags := requestModel.CreateRequestBody()
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -224,6 +320,15 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(r.HandleGroupMembership(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.ReadGroupMembership(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -252,12 +357,21 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 // CreateRequestBody creates a JSON encoded request body from the group resource data
 func (r *GroupResourceModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+	variables, diags := RequestVariables(r.Variables, r.VariablesMap)
+	if diags.HasError() {
+		return nil, diags
+	}
+	variables, diags = MergeSensitiveVariables(variables, r.SensitiveVariables)
+	if diags.HasError() {
+		return nil, diags
+	}
+
 	// Convert group resource data to API data model
 	group := GroupAPIModel{
 		InventoryID: r.InventoryID.ValueInt64(),
 		Name:        r.Name.ValueString(),
 		Description: r.Description.ValueString(),
-		Variables:   r.Variables.ValueString(),
+		Variables:   variables,
 	}
 
 	// Create JSON encoded request body
@@ -292,7 +406,251 @@ func (r *GroupResourceModel) ParseHTTPResponse(body []byte) diag.Diagnostics {
 	r.ID = types.Int64Value(resultAPIGroup.ID)
 	r.Name = types.StringValue(resultAPIGroup.Name)
 	r.Description = ParseStringValue(resultAPIGroup.Description)
-	r.Variables = ParseAAPCustomStringValue(resultAPIGroup.Variables)
+	variables, diags := RemoveSensitiveVariables(resultAPIGroup.Variables, r.SensitiveVariablesKeys)
+	if diags.HasError() {
+		return diags
+	}
+	r.Variables, r.VariablesMap, diags = ParseVariables(variables, r.Variables, r.VariablesMap, r.VariablesManagedKeys)
+
+	return diags
+}
+
+// HandleGroupMembership manages the child groups and hosts of a group, adding and removing the associations
+// that differ from the group resource data. Child groups and hosts are left untouched when not set.
+func (r *GroupResource) HandleGroupMembership(ctx context.Context, data GroupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	memberships := []struct {
+		name    string
+		members types.Set
+	}{
+		{name: "children", members: data.Children},
+		{name: "hosts", members: data.Hosts},
+	}
+	for _, membership := range memberships {
+		if membership.members.IsNull() || membership.members.IsUnknown() {
+			continue
+		}
+
+		elements := make([]int64, 0, len(membership.members.Elements()))
+		diags.Append(membership.members.ElementsAs(ctx, &elements, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		url, urlDiags := getURL(data.URL.ValueString(), membership.name)
+		diags.Append(urlDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		current, readDiags := ReadAssociatedIDs(ctx, r.client, url)
+		diags.Append(readDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		toBeAdded := sliceDifference(elements, current)
+		toBeRemoved := sliceDifference(current, elements)
+
+		if membership.name == "children" {
+			diags.Append(DetectGroupCycle(ctx, r.client, data.ID.ValueInt64(), toBeAdded)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		if len(toBeAdded) > 0 {
+			diags.Append(AssociateIDs(ctx, r.client, toBeAdded, url, false)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		if len(toBeRemoved) > 0 {
+			diags.Append(AssociateIDs(ctx, r.client, toBeRemoved, url, true)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	return diags
+}
+
+// ReadGroupMembership updates the child groups and hosts of the group resource data, when they are managed.
+func (r *GroupResource) ReadGroupMembership(ctx context.Context, data *GroupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for name, members := range map[string]*types.Set{"children": &data.Children, "hosts": &data.Hosts} {
+		if members.IsNull() {
+			continue
+		}
+
+		url, urlDiags := getURL(data.URL.ValueString(), name)
+		diags.Append(urlDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		ids, readDiags := ReadAssociatedIDs(ctx, r.client, url)
+		diags.Append(readDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		value, valueDiags := types.SetValueFrom(ctx, types.Int64Type, ids)
+		diags.Append(valueDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		*members = value
+	}
+
+	return diags
+}
+
+// DetectGroupCycle returns an error when adding the given children to the group would create a cycle in the
+// group hierarchy, that is when the group is one of the children or one of their descendants.
+func DetectGroupCycle(ctx context.Context, client ProviderHTTPClient, groupID int64, children []int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	groupsURL := path.Join(client.getAPIEndpoint(), "groups")
+	for _, child := range children {
+		// Walk the descendants of the child, recording the parent each group was reached from
+		parents := map[int64]int64{}
+		visited := map[int64]bool{child: true}
+		queue := []int64{child}
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+
+			if current == groupID {
+				var cycle []string
+				for id := groupID; ; id = parents[id] {
+					cycle = append(cycle, strconv.FormatInt(id, 10))
+					if id == child {
+						break
+					}
+				}
+				cycle = append(cycle, strconv.FormatInt(groupID, 10))
+				slices.Reverse(cycle)
+				diags.AddError(
+					"Group hierarchy cycle",
+					fmt.Sprintf("Adding group %d as a child of group %d would create a cycle in the group hierarchy: %s",
+						child, groupID, strings.Join(cycle, " -> ")),
+				)
+				return diags
+			}
+
+			grandchildren, readDiags := ReadAssociatedIDs(ctx, client, path.Join(groupsURL, strconv.FormatInt(current, 10), "children"))
+			diags.Append(readDiags...)
+			if diags.HasError() {
+				return diags
+			}
+			for _, grandchild := range grandchildren {
+				if !visited[grandchild] {
+					visited[grandchild] = true
+					parents[grandchild] = current
+					queue = append(queue, grandchild)
+				}
+			}
+		}
+	}
+
+	return diags
+}
+
+// ReadAssociatedIDs retrieves the IDs of all the objects listed by the given AAP API URL.
+func ReadAssociatedIDs(ctx context.Context, client ProviderHTTPClient, url string) ([]int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	ids := make([]int64, 0)
+
+	params := map[string]string{
+		"order_by":  "id",
+		"page_size": strconv.Itoa(groupAssociationsPageSize),
+	}
+	for page := 1; ; page++ {
+		if !IsContextActive(ctx, "ReadAssociatedIDs", &diags) {
+			return nil, diags
+		}
+
+		params["page"] = strconv.Itoa(page)
+		body, pageDiags := client.GetWithParams(url, params)
+		diags.Append(pageDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var idsPage struct {
+			Next    string `json:"next"`
+			Results []struct {
+				ID int64 `json:"id"`
+			} `json:"results"`
+		}
+		err := json.Unmarshal(body, &idsPage)
+		if err != nil {
+			diags.AddError("Error parsing JSON response from AAP", err.Error())
+			return nil, diags
+		}
+		for _, result := range idsPage.Results {
+			ids = append(ids, result.ID)
+		}
+
+		if idsPage.Next == "" {
+			return ids, diags
+		}
+	}
+}
+
+// AssociateIDs associates the objects with the given IDs through the given AAP API URL, or disassociates them.
+func AssociateIDs(ctx context.Context, client ProviderHTTPClient, ids []int64, url string, disassociate bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+
+	ctx, cancel := context.WithCancel(ctx)
+	// Make sure it's called to release resources even if no errors
+	defer cancel()
+
+	for _, id := range ids {
+		wg.Add(1)
+		go func(id int64) {
+			defer wg.Done()
+
+			// Check if any error occurred in any other goroutines
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+
+			body := map[string]int64{"id": id}
+			if disassociate {
+				body["disassociate"] = 1
+			}
+			jsonRaw, err := json.Marshal(body)
+			if err != nil {
+				mu.Lock()
+				diags.Append(diag.NewErrorDiagnostic("Body JSON Marshal Error", err.Error()))
+				mu.Unlock()
+				cancel()
+				return
+			}
+
+			resp, respBody, err := client.doRequest(http.MethodPost, url, nil, bytes.NewReader(jsonRaw))
+			respDiags := ValidateResponse(resp, respBody, err, []int{http.StatusNoContent})
+			if respDiags.HasError() {
+				mu.Lock()
+				diags.Append(respDiags...)
+				mu.Unlock()
+				cancel()
+			}
+		}(id)
+	}
+
+	// Wait for all goroutines to finish
+	wg.Wait()
 
 	return diags
 }
@@ -4,17 +4,22 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"reflect"
+	"slices"
 	"strings"
 	"testing"
 
 	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"go.uber.org/mock/gomock"
 )
 
 func TestGroupResourceSchema(t *testing.T) {
@@ -50,7 +55,7 @@ func TestGroupResourceCreateRequestBody(t *testing.T) {
 				Name:        types.StringValue("test group"),
 				Description: types.StringUnknown(),
 				URL:         types.StringUnknown(),
-				Variables:   customtypes.NewAAPCustomStringUnknown(),
+				Variables:   customtypes.NewAAPCustomVariablesUnknown(),
 				InventoryID: types.Int64Value(0),
 			},
 			expected: []byte(`{"inventory":0,"name":"test group"}`),
@@ -61,7 +66,7 @@ func TestGroupResourceCreateRequestBody(t *testing.T) {
 				Name:        types.StringValue("test group"),
 				Description: types.StringNull(),
 				URL:         types.StringNull(),
-				Variables:   customtypes.NewAAPCustomStringNull(),
+				Variables:   customtypes.NewAAPCustomVariablesNull(),
 				InventoryID: types.Int64Value(0),
 			},
 			expected: []byte(`{"inventory":0,"name":"test group"}`),
@@ -73,7 +78,7 @@ func TestGroupResourceCreateRequestBody(t *testing.T) {
 				Name:        types.StringValue("group1"),
 				Description: types.StringNull(),
 				URL:         types.StringValue("/api/v2/groups/1/"),
-				Variables:   customtypes.NewAAPCustomStringValue("{\"foo\":\"bar\"}"),
+				Variables:   customtypes.NewAAPCustomVariablesValue("{\"foo\":\"bar\"}"),
 			},
 			expected: []byte(
 				`{"inventory":1,"name":"group1","variables":"{\"foo\":\"bar\"}"}`,
@@ -86,7 +91,7 @@ func TestGroupResourceCreateRequestBody(t *testing.T) {
 				Name:        types.StringValue("group1"),
 				Description: types.StringValue("A test group"),
 				URL:         types.StringValue("/api/v2/groups/1/"),
-				Variables:   customtypes.NewAAPCustomStringValue("{\"foo\":\"bar\"}"),
+				Variables:   customtypes.NewAAPCustomVariablesValue("{\"foo\":\"bar\"}"),
 			},
 			expected: []byte(
 				`{"inventory":1,"name":"group1","description":"A test group","variables":"{\"foo\":\"bar\"}"}`,
@@ -152,7 +157,7 @@ func TestGroupResourceParseHTTPResponse(t *testing.T) {
 				Name:        types.StringValue("group1"),
 				URL:         types.StringValue("/api/v2/groups/1/"),
 				Description: types.StringValue("A basic test group"),
-				Variables:   customtypes.NewAAPCustomStringValue("{\"foo\":\"bar\",\"nested\":{\"foobar\":\"baz\"}}"),
+				Variables:   customtypes.NewAAPCustomVariablesValue("{\"foo\":\"bar\",\"nested\":{\"foobar\":\"baz\"}}"),
 			},
 			errors: diag.Diagnostics{},
 		},
@@ -172,6 +177,154 @@ func TestGroupResourceParseHTTPResponse(t *testing.T) {
 	}
 }
 
+// mockGroupChildren mocks the children of the groups of the given hierarchy, keyed by group ID.
+func mockGroupChildren(mock *MockProviderHTTPClient, hierarchy map[int64][]int64) {
+	for groupID, children := range hierarchy {
+		var results []string
+		for _, child := range children {
+			results = append(results, fmt.Sprintf(`{"id": %d}`, child))
+		}
+		mock.EXPECT().GetWithParams(fmt.Sprintf("/api/v2/groups/%d/children", groupID), gomock.Any()).Return(
+			[]byte(`{"results": [`+strings.Join(results, ",")+`]}`), diag.Diagnostics{},
+		).AnyTimes()
+	}
+}
+
+func TestDetectGroupCycle(t *testing.T) {
+	hierarchy := map[int64][]int64{
+		1: {2},
+		2: {3, 4},
+		3: {},
+		4: {5},
+		5: {},
+		6: {},
+	}
+
+	var testTable = []struct {
+		name          string
+		groupID       int64
+		children      []int64
+		expectedCycle string
+	}{
+		{name: "no children", groupID: 1, children: nil},
+		{name: "no cycle", groupID: 6, children: []int64{1}},
+		{name: "group is its own child", groupID: 3, children: []int64{3}, expectedCycle: "3 -> 3"},
+		{name: "parent as child", groupID: 2, children: []int64{1}, expectedCycle: "2 -> 1 -> 2"},
+		{name: "ancestor as child", groupID: 5, children: []int64{6, 1}, expectedCycle: "5 -> 1 -> 2 -> 4 -> 5"},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockProviderHTTPClient(ctrl)
+			mockClient.EXPECT().getAPIEndpoint().Return("/api/v2").AnyTimes()
+			mockGroupChildren(mockClient, hierarchy)
+
+			diags := DetectGroupCycle(t.Context(), mockClient, test.groupID, test.children)
+			if test.expectedCycle == "" {
+				if diags.HasError() {
+					t.Fatalf("Unexpected error: %v", diags.Errors())
+				}
+				return
+			}
+			if !diags.HasError() || !strings.HasSuffix(diags.Errors()[0].Detail(), ": "+test.expectedCycle) {
+				t.Errorf("Expected cycle %q, got %v", test.expectedCycle, diags.Errors())
+			}
+		})
+	}
+}
+
+func TestReadAssociatedIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockProviderHTTPClient(ctrl)
+	gomock.InOrder(
+		mockClient.EXPECT().GetWithParams("/api/v2/groups/1/hosts", map[string]string{"order_by": "id", "page_size": "200", "page": "1"}).Return(
+			[]byte(`{"next": "/api/v2/groups/1/hosts/?page=2", "results": [{"id": 3}, {"id": 4}]}`), diag.Diagnostics{},
+		),
+		mockClient.EXPECT().GetWithParams("/api/v2/groups/1/hosts", map[string]string{"order_by": "id", "page_size": "200", "page": "2"}).Return(
+			[]byte(`{"results": [{"id": 7}]}`), diag.Diagnostics{},
+		),
+	)
+
+	ids, diags := ReadAssociatedIDs(t.Context(), mockClient, "/api/v2/groups/1/hosts")
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags.Errors())
+	}
+	if !reflect.DeepEqual(ids, []int64{3, 4, 7}) {
+		t.Errorf("Expected IDs [3 4 7], got %v", ids)
+	}
+}
+
+func TestGroupResourceHandleGroupMembership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockProviderHTTPClient(ctrl)
+	mockClient.EXPECT().getAPIEndpoint().Return("/api/v2").AnyTimes()
+	// Group 1 has the child group 2 and the hosts 10 and 11
+	mockGroupChildren(mockClient, map[int64][]int64{1: {2}, 3: {}})
+	mockClient.EXPECT().GetWithParams("/api/v2/groups/1/hosts", gomock.Any()).Return(
+		[]byte(`{"results": [{"id": 10}, {"id": 11}]}`), diag.Diagnostics{},
+	)
+
+	var requests []string
+	mockClient.EXPECT().doRequest(http.MethodPost, gomock.Any(), nil, gomock.Any()).DoAndReturn(
+		func(_ string, url string, _ map[string]string, data io.Reader) (*http.Response, []byte, error) {
+			body, _ := io.ReadAll(data)
+			requests = append(requests, url+" "+string(body))
+			return &http.Response{StatusCode: http.StatusNoContent}, nil, nil
+		},
+	).Times(4)
+
+	data := GroupResourceModel{
+		ID:       types.Int64Value(1),
+		URL:      types.StringValue("/api/v2/groups/1/"),
+		Children: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(3)}),
+		Hosts:    types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(11), types.Int64Value(12)}),
+	}
+	group := GroupResource{client: mockClient}
+	diags := group.HandleGroupMembership(t.Context(), data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags.Errors())
+	}
+
+	slices.Sort(requests)
+	expected := []string{
+		`/api/v2/groups/1/children {"disassociate":1,"id":2}`,
+		`/api/v2/groups/1/children {"id":3}`,
+		`/api/v2/groups/1/hosts {"disassociate":1,"id":10}`,
+		`/api/v2/groups/1/hosts {"id":12}`,
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("Expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestGroupResourceHandleGroupMembershipCycle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockProviderHTTPClient(ctrl)
+	mockClient.EXPECT().getAPIEndpoint().Return("/api/v2").AnyTimes()
+	mockGroupChildren(mockClient, map[int64][]int64{1: {}, 2: {1}})
+
+	data := GroupResourceModel{
+		ID:       types.Int64Value(1),
+		URL:      types.StringValue("/api/v2/groups/1/"),
+		Children: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(2)}),
+		Hosts:    types.SetNull(types.Int64Type),
+	}
+	group := GroupResource{client: mockClient}
+	diags := group.HandleGroupMembership(t.Context(), data)
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Group hierarchy cycle" {
+		t.Errorf("Expected a group hierarchy cycle error, got %v", diags)
+	}
+}
+
 // Acceptance tests
 
 func TestAccGroupResource(t *testing.T) {
@@ -215,6 +368,82 @@ func TestAccGroupResource(t *testing.T) {
 	})
 }
 
+func TestAccGroupResourceMembership(t *testing.T) {
+	inventoryName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	groupName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with a child group and a host
+			{
+				Config: testAccGroupResourceMembership(inventoryName, groupName, "[aap_group.child_1.id]", "[aap_host.host_1.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameGroup, "children.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceNameGroup, "children.*", "aap_group.child_1", "id"),
+					resource.TestCheckResourceAttr(resourceNameGroup, "hosts.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceNameGroup, "hosts.*", "aap_host.host_1", "id"),
+				),
+			},
+			// Update by replacing the child group and adding a host
+			{
+				Config: testAccGroupResourceMembership(inventoryName, groupName,
+					"[aap_group.child_2.id]", "[aap_host.host_1.id, aap_host.host_2.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameGroup, "children.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceNameGroup, "children.*", "aap_group.child_2", "id"),
+					resource.TestCheckResourceAttr(resourceNameGroup, "hosts.#", "2"),
+				),
+			},
+			// Remove all the child groups and hosts
+			{
+				Config: testAccGroupResourceMembership(inventoryName, groupName, "[]", "[]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameGroup, "children.#", "0"),
+					resource.TestCheckResourceAttr(resourceNameGroup, "hosts.#", "0"),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckGroupResourceDestroy,
+	})
+}
+
+// testAccGroupResourceMembership returns a configuration for an AAP group with the given children and hosts.
+func testAccGroupResourceMembership(inventoryName, groupName, children, hosts string) string {
+	return fmt.Sprintf(`
+resource "aap_inventory" "test" {
+  name = "%[1]s"
+}
+
+resource "aap_group" "child_1" {
+  name = "%[2]s-child-1"
+  inventory_id = aap_inventory.test.id
+}
+
+resource "aap_group" "child_2" {
+  name = "%[2]s-child-2"
+  inventory_id = aap_inventory.test.id
+}
+
+resource "aap_host" "host_1" {
+  name = "%[2]s-host-1"
+  inventory_id = aap_inventory.test.id
+}
+
+resource "aap_host" "host_2" {
+  name = "%[2]s-host-2"
+  inventory_id = aap_inventory.test.id
+}
+
+resource "aap_group" "test" {
+  name = "%[2]s"
+  inventory_id = aap_inventory.test.id
+  children = %[3]s
+  hosts = %[4]s
+}`, inventoryName, groupName, children, hosts)
+}
+
 func testAccGroupResourceMinimal(inventoryName, groupName string) string {
 	return fmt.Sprintf(`
 resource "aap_inventory" "test" {
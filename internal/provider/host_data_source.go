@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// HostDataSourceModel maps the host data source schema data.
+type HostDataSourceModel struct {
+	ID                tftypes.Int64                       `tfsdk:"id"`
+	Name              tftypes.String                      `tfsdk:"name"`
+	InventoryID       tftypes.Int64                       `tfsdk:"inventory_id"`
+	URL               tftypes.String                      `tfsdk:"url"`
+	Description       tftypes.String                      `tfsdk:"description"`
+	Variables         customtypes.AAPCustomVariablesValue `tfsdk:"variables"`
+	VariablesMap      tftypes.Dynamic                     `tfsdk:"variables_map"`
+	Enabled           tftypes.Bool                        `tfsdk:"enabled"`
+	Groups            tftypes.Set                         `tfsdk:"groups"`
+	ExcludeFacts      tftypes.Bool                        `tfsdk:"exclude_facts"`
+	AnsibleFacts      tftypes.String                      `tfsdk:"ansible_facts"`
+	LastJobID         tftypes.Int64                       `tfsdk:"last_job_id"`
+	LastJobStatus     tftypes.String                      `tfsdk:"last_job_status"`
+	HasActiveFailures tftypes.Bool                        `tfsdk:"has_active_failures"`
+	InstanceID        tftypes.String                      `tfsdk:"instance_id"`
+}
+
+// HostDataSource is the data source implementation.
+type HostDataSource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &HostDataSource{}
+	_ datasource.DataSourceWithConfigure        = &HostDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &HostDataSource{}
+)
+
+// NewHostDataSource is a helper function to simplify the provider implementation.
+func NewHostDataSource() datasource.DataSource {
+	return &HostDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *HostDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host"
+}
+
+// Schema defines the schema fields for the data source.
+func (d *HostDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Host id",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the Host. Requires `inventory_id` when used to look up the Host.",
+			},
+			"inventory_id": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Identifier for the inventory the Host belongs to. Requires `name` when used to look up the Host.",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the Host",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Description of the Host",
+			},
+			"variables": schema.StringAttribute{
+				Computed:   true,
+				CustomType: customtypes.AAPCustomVariablesType{},
+				Description: "Variables of the Host. Will be either JSON or YAML string depending on how the " +
+					"variables were entered into AAP.",
+			},
+			"variables_map": schema.DynamicAttribute{
+				Computed:    true,
+				Description: "Variables of the Host as an object, for example to access `data.aap_host.example.variables_map.ansible_host`.",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the Host is enabled",
+			},
+			"groups": schema.SetAttribute{
+				Computed:    true,
+				ElementType: tftypes.Int64Type,
+				Description: "The identifiers of the groups the Host is a direct member of.",
+			},
+			"exclude_facts": schema.BoolAttribute{
+				Optional: true,
+				Description: "When set to `true`, the Ansible facts of the Host are not retrieved and `ansible_facts` " +
+					"is null. Use it to keep the state small for hosts with many facts.",
+			},
+			"ansible_facts": schema.StringAttribute{
+				Computed: true,
+				Description: "Ansible facts gathered for the Host by the jobs run with fact caching enabled, " +
+					"as a JSON string. Use `jsondecode` to access the values.",
+			},
+			"last_job_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ID of the last job that ran against the Host",
+			},
+			"last_job_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the last job that ran against the Host",
+			},
+			"has_active_failures": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the last job failed for the Host",
+			},
+			"instance_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The value used by the remote inventory source to uniquely identify the Host",
+			},
+		},
+		Description: "Get an existing Host, either by `id` or by `name` and `inventory_id`.",
+	}
+}
+
+// ConfigValidators returns configuration validators for the data source.
+func (d *HostDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	// You have at least an id or a name + inventory_id pair
+	return []datasource.ConfigValidator{
+		datasourcevalidator.Any(
+			datasourcevalidator.AtLeastOneOf(
+				tfpath.MatchRoot("id")),
+			datasourcevalidator.RequiredTogether(
+				tfpath.MatchRoot("name"),
+				tfpath.MatchRoot("inventory_id")),
+		),
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *HostDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *HostDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state HostDataSourceModel
+
+	// Check Read preconditions
+	if !DoReadPreconditionsMeet(ctx, resp, d.client) {
+		return
+	}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := GetHost(d.client, state.ID, state.Name, state.InventoryID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The host is parsed as for the host resource
+	host := HostResourceModel{ExcludeFacts: state.ExcludeFacts}
+	resp.Diagnostics.Append(host.ParseHTTPResponse(body)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(host.ReadFacts(d.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupsURL, diags := getURL(host.URL.ValueString(), "groups")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	groups, diags := ReadAssociatedIDs(ctx, d.client, groupsURL)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(host.UpdateStateWithGroups(ctx, groups)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variablesMap, diags := VariablesToMap(host.Variables.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state = HostDataSourceModel{
+		ID:                host.ID,
+		Name:              host.Name,
+		InventoryID:       host.InventoryID,
+		URL:               host.URL,
+		Description:       host.Description,
+		Variables:         host.Variables,
+		VariablesMap:      variablesMap,
+		Enabled:           host.Enabled,
+		Groups:            host.Groups,
+		ExcludeFacts:      state.ExcludeFacts,
+		AnsibleFacts:      host.AnsibleFacts,
+		LastJobID:         host.LastJobID,
+		LastJobStatus:     host.LastJobStatus,
+		HasActiveFailures: host.HasActiveFailures,
+		InstanceID:        host.InstanceID,
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// GetHost retrieves a host from AAP, either by id or by name within an inventory.
+func GetHost(client ProviderHTTPClient, id tftypes.Int64, name tftypes.String, inventoryID tftypes.Int64) ([]byte, diag.Diagnostics) {
+	if IsValueProvided(id) {
+		return client.Get(path.Join(client.getAPIEndpoint(), "hosts", strconv.FormatInt(id.ValueInt64(), 10)))
+	}
+
+	var diags diag.Diagnostics
+	hostsURL := path.Join(client.getAPIEndpoint(), "inventories", strconv.FormatInt(inventoryID.ValueInt64(), 10), "hosts")
+	body, getDiags := client.GetWithParams(hostsURL, map[string]string{"name": name.ValueString()})
+	diags.Append(getDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var hosts struct {
+		Count   int64             `json:"count"`
+		Results []json.RawMessage `json:"results"`
+	}
+	err := json.Unmarshal(body, &hosts)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return nil, diags
+	}
+	if hosts.Count != 1 || len(hosts.Results) != 1 {
+		diags.AddError(
+			"Host not found",
+			fmt.Sprintf("Expected exactly one host named %q in inventory %d, found %d.",
+				name.ValueString(), inventoryID.ValueInt64(), hosts.Count),
+		)
+		return nil, diags
+	}
+
+	return hosts.Results[0], diags
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHostDataSourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	schemaRequest := datasource.SchemaRequest{}
+	schemaResponse := &datasource.SchemaResponse{}
+
+	// Instantiate the HostDataSource and call its Schema method
+	NewHostDataSource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestGetHost(t *testing.T) {
+	hostsURL := "/api/v2/inventories/3/hosts"
+	hostBody := `{"id":1,"name":"host1","inventory":3,"url":"/api/v2/hosts/1/"}`
+
+	t.Run("by id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := NewMockProviderHTTPClient(ctrl)
+		mockClient.EXPECT().getAPIEndpoint().Return("/api/v2")
+		mockClient.EXPECT().Get("/api/v2/hosts/1").Return([]byte(hostBody), diag.Diagnostics{})
+
+		body, diags := GetHost(mockClient, tftypes.Int64Value(1), tftypes.StringNull(), tftypes.Int64Null())
+		if diags.HasError() {
+			t.Fatal(diags.Errors())
+		}
+		if string(body) != hostBody {
+			t.Errorf("Expected (%s) not equal to actual (%s)", hostBody, body)
+		}
+	})
+
+	t.Run("by name and inventory", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := NewMockProviderHTTPClient(ctrl)
+		mockClient.EXPECT().getAPIEndpoint().Return("/api/v2")
+		mockClient.EXPECT().GetWithParams(hostsURL, map[string]string{"name": "host1"}).Return(
+			[]byte(`{"count":1,"results":[`+hostBody+`]}`),
+			diag.Diagnostics{},
+		)
+
+		body, diags := GetHost(mockClient, tftypes.Int64Null(), tftypes.StringValue("host1"), tftypes.Int64Value(3))
+		if diags.HasError() {
+			t.Fatal(diags.Errors())
+		}
+		if string(body) != hostBody {
+			t.Errorf("Expected (%s) not equal to actual (%s)", hostBody, body)
+		}
+	})
+
+	t.Run("host not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := NewMockProviderHTTPClient(ctrl)
+		mockClient.EXPECT().getAPIEndpoint().Return("/api/v2")
+		mockClient.EXPECT().GetWithParams(hostsURL, map[string]string{"name": "missing"}).Return(
+			[]byte(`{"count":0,"results":[]}`),
+			diag.Diagnostics{},
+		)
+
+		_, diags := GetHost(mockClient, tftypes.Int64Null(), tftypes.StringValue("missing"), tftypes.Int64Value(3))
+		expected := diag.Diagnostics{}
+		expected.AddError("Host not found", `Expected exactly one host named "missing" in inventory 3, found 0.`)
+		if !diags.Equal(expected) {
+			t.Errorf("Expected error diagnostics (%s), actual was (%s)", expected, diags)
+		}
+	})
+}
+
+func TestAccHostDataSource(t *testing.T) {
+	inventoryName := "test-inventory-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	hostName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostDataSource(inventoryName, hostName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.aap_host.by_id", "name", "aap_host.test", "name"),
+					resource.TestCheckResourceAttrPair("data.aap_host.by_id", "inventory_id", "aap_inventory.test", "id"),
+					resource.TestCheckResourceAttr("data.aap_host.by_id", "variables", hostVariable),
+					resource.TestCheckResourceAttr("data.aap_host.by_id", "groups.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aap_host.by_id", "groups.0", "aap_group.test", "id"),
+					resource.TestCheckResourceAttr("data.aap_host.by_id", "ansible_facts", "{}"),
+					resource.TestCheckResourceAttr("data.aap_host.by_id", "has_active_failures", "false"),
+					resource.TestCheckResourceAttrPair("data.aap_host.by_name", "id", "aap_host.test", "id"),
+					resource.TestCheckNoResourceAttr("data.aap_host.by_name", "ansible_facts"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHostDataSource(inventoryName, hostName string) string {
+	return fmt.Sprintf(`
+resource "aap_inventory" "test" {
+  name = "%[1]s"
+}
+
+resource "aap_group" "test" {
+  name         = "webservers"
+  inventory_id = aap_inventory.test.id
+}
+
+resource "aap_host" "test" {
+  name         = "%[2]s"
+  inventory_id = aap_inventory.test.id
+  variables    = jsonencode({ "foo" : "bar" })
+  groups       = [aap_group.test.id]
+}
+
+data "aap_host" "by_id" {
+  id         = aap_host.test.id
+  depends_on = [aap_host.test]
+}
+
+data "aap_host" "by_name" {
+  name          = aap_host.test.name
+  inventory_id  = aap_inventory.test.id
+  exclude_facts = true
+}
+`, inventoryName, hostName)
+}
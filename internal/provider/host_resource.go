@@ -5,10 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"path"
 	"slices"
-	"sync"
 
 	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -36,16 +34,48 @@ type HostAPIModel struct {
 	ID          int64  `json:"id,omitempty"`
 }
 
+// HostDetailsAPIModel represents the AAP API model of a host including its read-only fields.
+type HostDetailsAPIModel struct {
+	HostAPIModel
+	InstanceID        string                    `json:"instance_id"`
+	HasActiveFailures bool                      `json:"has_active_failures"`
+	LastJob           *int64                    `json:"last_job"`
+	SummaryFields     HostSummaryFieldsAPIModel `json:"summary_fields"`
+}
+
+// HostSummaryFieldsAPIModel represents the summary_fields of a host.
+type HostSummaryFieldsAPIModel struct {
+	LastJob HostLastJobSummaryAPIModel `json:"last_job"`
+}
+
+// HostLastJobSummaryAPIModel represents the last job summary of a host.
+type HostLastJobSummaryAPIModel struct {
+	ID     int64  `json:"id"`
+	Status string `json:"status"`
+}
+
 // HostResourceModel maps the host resource schema to a Go struct
 type HostResourceModel struct {
-	InventoryID types.Int64                      `tfsdk:"inventory_id"`
-	Name        types.String                     `tfsdk:"name"`
-	URL         types.String                     `tfsdk:"url"`
-	Description types.String                     `tfsdk:"description"`
-	Variables   customtypes.AAPCustomStringValue `tfsdk:"variables"`
-	Groups      types.Set                        `tfsdk:"groups"`
-	Enabled     types.Bool                       `tfsdk:"enabled"`
-	ID          types.Int64                      `tfsdk:"id"`
+	InventoryID               types.Int64                         `tfsdk:"inventory_id"`
+	Name                      types.String                        `tfsdk:"name"`
+	URL                       types.String                        `tfsdk:"url"`
+	Description               types.String                        `tfsdk:"description"`
+	Variables                 customtypes.AAPCustomVariablesValue `tfsdk:"variables"`
+	VariablesMap              types.Dynamic                       `tfsdk:"variables_map"`
+	VariablesManagedKeys      types.Bool                          `tfsdk:"variables_managed_keys"`
+	SensitiveVariables        types.Map                           `tfsdk:"sensitive_variables"`
+	SensitiveVariablesVersion types.Int64                         `tfsdk:"sensitive_variables_version"`
+	SensitiveVariablesKeys    types.Set                           `tfsdk:"sensitive_variables_keys"`
+	Groups                    types.Set                           `tfsdk:"groups"`
+	Enabled                   types.Bool                          `tfsdk:"enabled"`
+	ID                        types.Int64                         `tfsdk:"id"`
+
+	ExcludeFacts      types.Bool   `tfsdk:"exclude_facts"`
+	AnsibleFacts      types.String `tfsdk:"ansible_facts"`
+	LastJobID         types.Int64  `tfsdk:"last_job_id"`
+	LastJobStatus     types.String `tfsdk:"last_job_status"`
+	HasActiveFailures types.Bool   `tfsdk:"has_active_failures"`
+	InstanceID        types.String `tfsdk:"instance_id"`
 }
 
 // HostResource is the resource implementation.
@@ -55,8 +85,9 @@ type HostResource struct {
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &HostResource{}
-	_ resource.ResourceWithConfigure = &HostResource{}
+	_ resource.Resource                     = &HostResource{}
+	_ resource.ResourceWithConfigure        = &HostResource{}
+	_ resource.ResourceWithConfigValidators = &HostResource{}
 )
 
 // NewHostResource is a helper function to simplify the provider implementation.
@@ -118,10 +149,16 @@ func (r *HostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "Description for the host",
 			},
 			"variables": schema.StringAttribute{
-				Description: "Variables for the host configuration. Must be provided as either a JSON or YAML string.",
-				Optional:    true,
-				CustomType:  customtypes.AAPCustomStringType{},
+				Description: "Variables for the host configuration. Must be provided as either a JSON or YAML string. " +
+					"Conflicts with `variables_map`.",
+				Optional:   true,
+				CustomType: customtypes.AAPCustomVariablesType{},
 			},
+			"variables_map":               variablesMapAttribute("host"),
+			"variables_managed_keys":      variablesManagedKeysAttribute("host"),
+			"sensitive_variables":         sensitiveVariablesAttribute("host"),
+			"sensitive_variables_version": sensitiveVariablesVersionAttribute(),
+			"sensitive_variables_keys":    sensitiveVariablesKeysAttribute(),
 			"enabled": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
@@ -137,11 +174,42 @@ func (r *HostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Validators:  []validator.Set{setvalidator.SizeAtLeast(1)},
 				Description: "The list of groups to assosicate with a host.",
 			},
+			"exclude_facts": schema.BoolAttribute{
+				Optional: true,
+				Description: "When set to `true`, the Ansible facts of the host are not retrieved and `ansible_facts` " +
+					"is null. Use it to keep the state small for hosts with many facts.",
+			},
+			"ansible_facts": schema.StringAttribute{
+				Computed: true,
+				Description: "Ansible facts gathered for the host by the jobs run with fact caching enabled, " +
+					"as a JSON string. Use `jsondecode` to access the values.",
+			},
+			"last_job_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ID of the last job that ran against the host",
+			},
+			"last_job_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the last job that ran against the host",
+			},
+			"has_active_failures": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the last job failed for the host",
+			},
+			"instance_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The value used by the remote inventory source to uniquely identify the host",
+			},
 		},
 		Description: `Creates a host.`,
 	}
 }
 
+// ConfigValidators returns the configuration validators of the resource.
+func (r *HostResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return variablesConfigValidators()
+}
+
 // Create creates the host resource and sets the Terraform state on success.
 func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data HostResourceModel
@@ -153,6 +221,16 @@ func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	// WriteOnly attributes (sensitive_variables) must be read from the config,
+	// not the plan, because WriteOnly values are always null in the plan.
+	var configData HostResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.SensitiveVariables = configData.SensitiveVariables
+	data.SensitiveVariablesKeys = SensitiveVariablesKeys(data.SensitiveVariables)
+
 	// Create request body from host data
 	createRequestBody, diags := data.CreateRequestBody()
 	resp.Diagnostics.Append(diags...)
@@ -177,6 +255,11 @@ func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(data.ReadFacts(r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if !data.Groups.IsNull() {
 		elements := make([]int64, 0, len(data.Groups.Elements()))
 		resp.Diagnostics.Append(data.Groups.ElementsAs(ctx, &elements, false)...)
@@ -239,6 +322,11 @@ func (r *HostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	resp.Diagnostics.Append(data.ReadFacts(r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	groups, diags := r.ReadAssociatedGroups(data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -268,8 +356,35 @@ func (r *HostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	// WriteOnly attributes (sensitive_variables) must be read from the config,
+	// not the plan, because WriteOnly values are always null in the plan.
+	var configData HostResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.SensitiveVariables = configData.SensitiveVariables
+	data.SensitiveVariablesKeys = SensitiveVariablesKeys(data.SensitiveVariables)
+
+	// Only update the managed keys of the variables
+	requestModel := data
+	if data.VariablesManagedKeys.ValueBool() {
+		var state HostResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		requestModel.Variables, diags = MergeManagedVariables(r.client, data.URL.ValueString(),
+			state.Variables, state.VariablesMap, state.SensitiveVariablesKeys, data.Variables, data.VariablesMap)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		requestModel.VariablesMap = types.DynamicNull()
+	}
+
 	// Create request body from host data
-	updateRequestBody, diags := data.CreateRequestBody()
+	updateRequestBody, diags := requestModel.CreateRequestBody()
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -290,6 +405,11 @@ func (r *HostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(data.ReadFacts(r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(r.HandleGroupAssociation(ctx, data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -355,12 +475,21 @@ func (r *HostResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 // CreateRequestBody creates a JSON encoded request body from the host resource data
 func (r *HostResourceModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+	variables, diags := RequestVariables(r.Variables, r.VariablesMap)
+	if diags.HasError() {
+		return nil, diags
+	}
+	variables, diags = MergeSensitiveVariables(variables, r.SensitiveVariables)
+	if diags.HasError() {
+		return nil, diags
+	}
+
 	// Convert host resource data to API data model
 	host := HostAPIModel{
 		InventoryID: r.InventoryID.ValueInt64(),
 		Name:        r.Name.ValueString(),
 		Description: r.Description.ValueString(),
-		Variables:   r.Variables.ValueString(),
+		Variables:   variables,
 		Enabled:     r.Enabled.ValueBool(),
 	}
 
@@ -383,7 +512,7 @@ func (r *HostResourceModel) ParseHTTPResponse(body []byte) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	// Unmarshal the JSON response
-	var resultAPIHost HostAPIModel
+	var resultAPIHost HostDetailsAPIModel
 	err := json.Unmarshal(body, &resultAPIHost)
 	if err != nil {
 		diags.AddError("Error parsing JSON response from AAP", err.Error())
@@ -397,11 +526,67 @@ func (r *HostResourceModel) ParseHTTPResponse(body []byte) diag.Diagnostics {
 	r.Name = types.StringValue(resultAPIHost.Name)
 	r.Enabled = basetypes.NewBoolValue(resultAPIHost.Enabled)
 	r.Description = ParseStringValue(resultAPIHost.Description)
-	r.Variables = ParseAAPCustomStringValue(resultAPIHost.Variables)
+	variables, diags := RemoveSensitiveVariables(resultAPIHost.Variables, r.SensitiveVariablesKeys)
+	if diags.HasError() {
+		return diags
+	}
+	r.Variables, r.VariablesMap, diags = ParseVariables(variables, r.Variables, r.VariablesMap, r.VariablesManagedKeys)
+	if diags.HasError() {
+		return diags
+	}
+	r.InstanceID = ParseStringValue(resultAPIHost.InstanceID)
+	r.HasActiveFailures = types.BoolValue(resultAPIHost.HasActiveFailures)
+	r.LastJobID = types.Int64Null()
+	if resultAPIHost.LastJob != nil {
+		r.LastJobID = types.Int64Value(*resultAPIHost.LastJob)
+	}
+	r.LastJobStatus = ParseStringValue(resultAPIHost.SummaryFields.LastJob.Status)
 
 	return diags
 }
 
+// ReadFacts updates the Ansible facts of the host resource data, unless they are excluded.
+func (r *HostResourceModel) ReadFacts(client ProviderHTTPClient) diag.Diagnostics {
+	if r.ExcludeFacts.ValueBool() {
+		r.AnsibleFacts = types.StringNull()
+		return nil
+	}
+
+	facts, diags := ReadHostFacts(client, r.URL.ValueString())
+	if diags.HasError() {
+		return diags
+	}
+	r.AnsibleFacts = types.StringValue(facts)
+
+	return diags
+}
+
+// ReadHostFacts retrieves the Ansible facts of a host as a JSON string with sorted keys.
+func ReadHostFacts(client ProviderHTTPClient, hostURL string) (string, diag.Diagnostics) {
+	url, diags := getURL(hostURL, "ansible_facts")
+	if diags.HasError() {
+		return "", diags
+	}
+
+	body, getDiags := client.Get(url)
+	diags.Append(getDiags...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	// Numbers are decoded as such, so that large integers in facts are not rounded
+	var facts map[string]any
+	err := unmarshalUseNumber(body, &facts)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return "", diags
+	}
+
+	encoded, encodeDiags := encodeVariables(facts)
+	diags.Append(encodeDiags...)
+	return encoded, diags
+}
+
 func extractIDs(data map[string]interface{}) []int64 {
 	var ids []int64
 
@@ -513,60 +698,8 @@ func (r *HostResourceModel) UpdateStateWithGroups(ctx context.Context, groups []
 
 // AssociateGroups associates groups with a host.
 func (r *HostResource) AssociateGroups(ctx context.Context, data []int64, url string, args ...bool) diag.Diagnostics {
-	var diags diag.Diagnostics
-	var wg sync.WaitGroup
-	disassociate := false
-
 	// If disassociate is not provided (zero value), use default value (false)
-	if len(args) > 0 {
-		disassociate = args[0]
-	}
-
-	ctx, cancel := context.WithCancel(ctx)
-	// Make sure it's called to release resources even if no errors
-	defer cancel()
-
-	for _, elem := range data {
-		wg.Add(1)
-		go func(elem int64) {
-			defer wg.Done()
-
-			// Check if any error occurred in any other gorouties
-			select {
-			case <-ctx.Done():
-				// Error somewhere, terminate
-				return
-			default: // Default is must to avoid blocking
-			}
+	disassociate := len(args) > 0 && args[0]
 
-			body := make(map[string]int64)
-			body["id"] = elem
-			if disassociate {
-				body["disassociate"] = 1
-			}
-			jsonRaw, err := json.Marshal(body)
-			if err != nil {
-				diags.Append(diag.NewErrorDiagnostic("Body JSON Marshal Error", err.Error()))
-				cancel()
-				return
-			}
-			reqData := bytes.NewReader(jsonRaw)
-
-			resp, bodyreq, err := r.client.doRequest(http.MethodPost, url, nil, reqData)
-			diags.Append(ValidateResponse(resp, bodyreq, err, []int{http.StatusNoContent})...)
-			if diags.HasError() {
-				cancel()
-				return
-			}
-		}(elem)
-	}
-
-	// Wait for all goroutines to finish
-	wg.Wait()
-
-	if diags.HasError() {
-		return diags
-	}
-
-	return diags
+	return AssociateIDs(ctx, r.client, data, url, disassociate)
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"go.uber.org/mock/gomock"
 )
 
 const hostVariable = "{\"foo\":\"bar\"}"
@@ -129,7 +130,7 @@ func TestHostResourceCreateRequestBody(t *testing.T) {
 				Name:        types.StringValue("test host"),
 				Description: types.StringUnknown(),
 				URL:         types.StringUnknown(),
-				Variables:   customtypes.NewAAPCustomStringUnknown(),
+				Variables:   customtypes.NewAAPCustomVariablesUnknown(),
 				Enabled:     basetypes.NewBoolValue(false),
 				InventoryID: types.Int64Value(0),
 			},
@@ -141,7 +142,7 @@ func TestHostResourceCreateRequestBody(t *testing.T) {
 				Name:        types.StringValue("test host"),
 				Description: types.StringNull(),
 				URL:         types.StringNull(),
-				Variables:   customtypes.NewAAPCustomStringNull(),
+				Variables:   customtypes.NewAAPCustomVariablesNull(),
 				Enabled:     basetypes.NewBoolValue(false),
 				InventoryID: types.Int64Value(0),
 				Groups:      types.SetNull(types.Int64Type),
@@ -155,7 +156,7 @@ func TestHostResourceCreateRequestBody(t *testing.T) {
 				Name:        types.StringValue("host1"),
 				Description: types.StringNull(),
 				URL:         types.StringValue("/api/v2/hosts/1/"),
-				Variables:   customtypes.NewAAPCustomStringValue(hostVariable),
+				Variables:   customtypes.NewAAPCustomVariablesValue(hostVariable),
 			},
 			expected: []byte(
 				`{"inventory":1,"name":"host1","variables":"{\"foo\":\"bar\"}","enabled":false}`,
@@ -168,7 +169,7 @@ func TestHostResourceCreateRequestBody(t *testing.T) {
 				Name:        types.StringValue("host1"),
 				Description: types.StringValue("A test host"),
 				URL:         types.StringValue("/api/v2/hosts/1/"),
-				Variables:   customtypes.NewAAPCustomStringValue("{\"foo\":\"bar\"}"),
+				Variables:   customtypes.NewAAPCustomVariablesValue("{\"foo\":\"bar\"}"),
 				Enabled:     basetypes.NewBoolValue(false),
 				Groups:      types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Value(2)}),
 			},
@@ -217,6 +218,8 @@ func TestHostResourceParseHTTPResponse(t *testing.T) {
 				URL:         types.StringValue("/api/v2/hosts/1/"),
 				Description: types.StringNull(),
 				Enabled:     basetypes.NewBoolValue(false),
+
+				HasActiveFailures: types.BoolValue(false),
 			},
 			errors: diag.Diagnostics{},
 		},
@@ -232,8 +235,30 @@ func TestHostResourceParseHTTPResponse(t *testing.T) {
 				Name:        types.StringValue("host1"),
 				URL:         types.StringValue("/api/v2/hosts/1/"),
 				Description: types.StringValue("A basic test host"),
-				Variables:   customtypes.NewAAPCustomStringValue("{\"foo\":\"bar\",\"nested\":{\"foobar\":\"baz\"}}"),
+				Variables:   customtypes.NewAAPCustomVariablesValue("{\"foo\":\"bar\",\"nested\":{\"foobar\":\"baz\"}}"),
+				Enabled:     basetypes.NewBoolValue(true),
+
+				HasActiveFailures: types.BoolValue(false),
+			},
+			errors: diag.Diagnostics{},
+		},
+		{
+			name: "test with last job and instance id",
+			input: []byte(`{"inventory":1,"name":"host1","enabled":true,"url":"/api/v2/hosts/1/","id":1,` +
+				`"instance_id":"i-0123","has_active_failures":true,"last_job":42,` +
+				`"summary_fields":{"last_job":{"id":42,"status":"failed"}}}`),
+			expected: HostResourceModel{
+				InventoryID: types.Int64Value(1),
+				ID:          types.Int64Value(1),
+				Name:        types.StringValue("host1"),
+				URL:         types.StringValue("/api/v2/hosts/1/"),
+				Description: types.StringNull(),
 				Enabled:     basetypes.NewBoolValue(true),
+
+				InstanceID:        types.StringValue("i-0123"),
+				HasActiveFailures: types.BoolValue(true),
+				LastJobID:         types.Int64Value(42),
+				LastJobStatus:     types.StringValue("failed"),
 			},
 			errors: diag.Diagnostics{},
 		},
@@ -253,6 +278,66 @@ func TestHostResourceParseHTTPResponse(t *testing.T) {
 	}
 }
 
+func TestHostResourceReadFacts(t *testing.T) {
+	t.Run("facts are sorted and numbers preserved", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := NewMockProviderHTTPClient(ctrl)
+		mockClient.EXPECT().Get("/api/v2/hosts/1/ansible_facts").Return(
+			[]byte(`{"ansible_memtotal_mb":18446744073709551615,"ansible_distribution":"RedHat"}`),
+			diag.Diagnostics{},
+		)
+
+		data := HostResourceModel{URL: types.StringValue("/api/v2/hosts/1/"), ExcludeFacts: types.BoolNull()}
+		diags := data.ReadFacts(mockClient)
+		if diags.HasError() {
+			t.Fatal(diags.Errors())
+		}
+		expected := types.StringValue(`{"ansible_distribution":"RedHat","ansible_memtotal_mb":18446744073709551615}`)
+		if !expected.Equal(data.AnsibleFacts) {
+			t.Errorf("Expected (%s) not equal to actual (%s)", expected, data.AnsibleFacts)
+		}
+	})
+
+	t.Run("excluded facts are not retrieved", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := NewMockProviderHTTPClient(ctrl)
+
+		data := HostResourceModel{
+			URL:          types.StringValue("/api/v2/hosts/1/"),
+			ExcludeFacts: types.BoolValue(true),
+			AnsibleFacts: types.StringValue("{}"),
+		}
+		diags := data.ReadFacts(mockClient)
+		if diags.HasError() {
+			t.Fatal(diags.Errors())
+		}
+		if !data.AnsibleFacts.IsNull() {
+			t.Errorf("Expected null facts, got (%s)", data.AnsibleFacts)
+		}
+	})
+
+	t.Run("returns request errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		errorDiags := diag.Diagnostics{}
+		errorDiags.AddError("Client request error", "not found")
+
+		mockClient := NewMockProviderHTTPClient(ctrl)
+		mockClient.EXPECT().Get("/api/v2/hosts/1/ansible_facts").Return(nil, errorDiags)
+
+		data := HostResourceModel{URL: types.StringValue("/api/v2/hosts/1/")}
+		diags := data.ReadFacts(mockClient)
+		if !diags.Equal(errorDiags) {
+			t.Errorf("Expected error diagnostics (%s), actual was (%s)", errorDiags, diags)
+		}
+	})
+}
+
 // Acceptance tests
 
 func TestAccHostResource(t *testing.T) {
@@ -280,6 +365,9 @@ func TestAccHostResource(t *testing.T) {
 					checkBasicHostAttributes(t, resourceNameHost, hostName),
 					testAccCheckHostResourceExists(resourceNameHost, &hostAPIModel),
 					testAccCheckHostResourceValues(&hostAPIModel, hostName, "", ""),
+					resource.TestCheckResourceAttr(resourceNameHost, "ansible_facts", "{}"),
+					resource.TestCheckResourceAttr(resourceNameHost, "has_active_failures", "false"),
+					resource.TestCheckNoResourceAttr(resourceNameHost, "last_job_id"),
 				),
 			},
 			// Update and Read testing
@@ -291,6 +379,7 @@ func TestAccHostResource(t *testing.T) {
 					checkBasicHostAttributes(t, resourceNameHost, updatedName),
 					resource.TestCheckResourceAttr(resourceNameHost, "description", updatedDescription),
 					resource.TestCheckResourceAttr(resourceNameHost, "variables", updatedVariables),
+					resource.TestCheckNoResourceAttr(resourceNameHost, "ansible_facts"),
 				),
 			},
 		},
@@ -330,6 +419,7 @@ resource "aap_host" "test" {
   variables = "{\"foo\":\"bar\"}"
   enabled = true
   groups = [aap_group.test.id]
+  exclude_facts = true
 }`, inventoryName, groupName, hostName)
 }
 
@@ -347,6 +437,157 @@ resource "aap_host" "test" {
 }`, inventoryName, hostName)
 }
 
+func TestAccHostResourceVariablesMap(t *testing.T) {
+	var hostAPIModel HostAPIModel
+	inventoryName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	hostName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostResourceVariablesMap(inventoryName, hostName, `variables = "foo: bar"
+  variables_map = { foo = "bar" }`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: testAccHostResourceVariablesMap(inventoryName, hostName, `variables_map = { port = 22, tags = ["web", true] }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckHostResourceExists(resourceNameHost, &hostAPIModel),
+					testAccCheckHostResourceValues(&hostAPIModel, hostName, "", `{"port":22,"tags":["web",true]}`),
+					resource.TestCheckNoResourceAttr(resourceNameHost, "variables"),
+					resource.TestCheckResourceAttr(resourceNameHost, "variables_map.port", "22"),
+					resource.TestCheckResourceAttr(resourceNameHost, "variables_map.tags.1", "true"),
+				),
+			},
+			{
+				Config: testAccHostResourceVariablesMap(inventoryName, hostName, `variables_map = { port = 2222, tags = ["web", true] }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckHostResourceExists(resourceNameHost, &hostAPIModel),
+					testAccCheckHostResourceValues(&hostAPIModel, hostName, "", `{"port":2222,"tags":["web",true]}`),
+					resource.TestCheckResourceAttr(resourceNameHost, "variables_map.port", "2222"),
+				),
+			},
+			// Switch back to the variables string
+			{
+				Config: testAccHostResourceVariablesMap(inventoryName, hostName, `variables = "{\"foo\":\"bar\"}"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameHost, "variables", hostVariable),
+					resource.TestCheckNoResourceAttr(resourceNameHost, "variables_map"),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckHostResourceDestroy,
+	})
+}
+
+// testAccHostResourceVariablesMap returns a configuration for an AAP host with the provided variables attributes.
+func testAccHostResourceVariablesMap(inventoryName, hostName, variables string) string {
+	return fmt.Sprintf(`
+resource "aap_inventory" "test" {
+  name = "%s"
+}
+
+resource "aap_host" "test" {
+  name = "%s"
+  inventory_id = aap_inventory.test.id
+  %s
+}`, inventoryName, hostName, variables)
+}
+
+func TestAccHostResourceVariablesManagedKeys(t *testing.T) {
+	var hostAPIModel HostAPIModel
+	inventoryName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	hostName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	managedKeys := `variables_managed_keys = true
+  variables = jsonencode({ foo = "%s" })`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostResourceVariablesMap(inventoryName, hostName, fmt.Sprintf(managedKeys, "bar")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckHostResourceExists(resourceNameHost, &hostAPIModel),
+					testAccCheckHostResourceValues(&hostAPIModel, hostName, "", `{"foo":"bar"}`),
+				),
+			},
+			// Keys written by other automation are ignored
+			{
+				PreConfig: func() {
+					hostAPIModel.Variables = `{"facts_cache":1,"foo":"bar"}`
+					body, err := json.Marshal(hostAPIModel)
+					if err == nil {
+						_, err = testUpdateResource(hostAPIModel.URL, body)
+					}
+					if err != nil {
+						t.Fatalf("Could not update the host variables: %s", err)
+					}
+				},
+				Config:   testAccHostResourceVariablesMap(inventoryName, hostName, fmt.Sprintf(managedKeys, "bar")),
+				PlanOnly: true,
+			},
+			// Managed keys are merged into the variables in AAP
+			{
+				Config: testAccHostResourceVariablesMap(inventoryName, hostName, fmt.Sprintf(managedKeys, "baz")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckHostResourceExists(resourceNameHost, &hostAPIModel),
+					testAccCheckHostResourceValues(&hostAPIModel, hostName, "", `{"facts_cache":1,"foo":"baz"}`),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckHostResourceDestroy,
+	})
+}
+
+func TestAccHostResourceSensitiveVariables(t *testing.T) {
+	var hostAPIModel HostAPIModel
+	inventoryName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	hostName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	sensitiveVariables := `variables = jsonencode({ foo = "bar" })
+  sensitive_variables = { ansible_become_password = "%s" }
+  sensitive_variables_version = %d`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostResourceVariablesMap(inventoryName, hostName, fmt.Sprintf(sensitiveVariables, "secret", 1)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckHostResourceExists(resourceNameHost, &hostAPIModel),
+					testAccCheckHostResourceValues(&hostAPIModel, hostName, "", `{"ansible_become_password":"secret","foo":"bar"}`),
+					resource.TestCheckResourceAttr(resourceNameHost, "variables", `{"foo":"bar"}`),
+					resource.TestCheckNoResourceAttr(resourceNameHost, "sensitive_variables"),
+					resource.TestCheckResourceAttr(resourceNameHost, "sensitive_variables_keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceNameHost, "sensitive_variables_keys.0", "ansible_become_password"),
+				),
+			},
+			// Changes to the sensitive variables are only sent when the version changes
+			{
+				Config:   testAccHostResourceVariablesMap(inventoryName, hostName, fmt.Sprintf(sensitiveVariables, "rotated", 1)),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccHostResourceVariablesMap(inventoryName, hostName, fmt.Sprintf(sensitiveVariables, "rotated", 2)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckHostResourceExists(resourceNameHost, &hostAPIModel),
+					testAccCheckHostResourceValues(&hostAPIModel, hostName, "", `{"ansible_become_password":"rotated","foo":"bar"}`),
+				),
+			},
+			// The sensitive variables keys can not be set in the variables
+			{
+				Config: testAccHostResourceVariablesMap(inventoryName, hostName, `variables = jsonencode({ password = "bar" })
+  sensitive_variables = { password = "secret" }`),
+				ExpectError: regexp.MustCompile("Invalid sensitive_variables value"),
+			},
+		},
+		CheckDestroy: testAccCheckHostResourceDestroy,
+	})
+}
+
 func TestAccHostResourceDeleteWithRetry(t *testing.T) {
 	var hostAPIModel HostAPIModel
 	hostName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
@@ -0,0 +1,445 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"sync"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// hostsPageSize is the maximum page size accepted by the AAP API.
+	hostsPageSize = 200
+	// hostsMaxParallelRequests limits the number of pages fetched concurrently.
+	hostsMaxParallelRequests = 5
+)
+
+// HostListAPIModel represents a page of the AAP API host list.
+type HostListAPIModel struct {
+	Count   int64                  `json:"count"`
+	Next    string                 `json:"next,omitempty"`
+	Results []HostListItemAPIModel `json:"results"`
+}
+
+// HostListItemAPIModel represents a host returned by the AAP API host list.
+type HostListItemAPIModel struct {
+	ID            int64                         `json:"id"`
+	URL           string                        `json:"url"`
+	Name          string                        `json:"name"`
+	Description   string                        `json:"description,omitempty"`
+	Enabled       bool                          `json:"enabled"`
+	Variables     string                        `json:"variables,omitempty"`
+	Inventory     int64                         `json:"inventory"`
+	SummaryFields HostListSummaryFieldsAPIModel `json:"summary_fields"`
+}
+
+// HostListSummaryFieldsAPIModel represents the summary_fields of a host in the AAP API host list.
+type HostListSummaryFieldsAPIModel struct {
+	Groups HostGroupsSummaryAPIModel `json:"groups"`
+}
+
+// HostGroupsSummaryAPIModel represents the groups summary of a host. AAP truncates the results
+// to the first groups, Count holds the total number of groups.
+type HostGroupsSummaryAPIModel struct {
+	Count   int64          `json:"count"`
+	Results []SummaryField `json:"results"`
+}
+
+// HostsDataSourceModel maps the hosts data source schema data.
+type HostsDataSourceModel struct {
+	InventoryID       tftypes.Int64    `tfsdk:"inventory_id"`
+	NameContains      tftypes.String   `tfsdk:"name_contains"`
+	Enabled           tftypes.Bool     `tfsdk:"enabled"`
+	GroupID           tftypes.Int64    `tfsdk:"group_id"`
+	HasActiveFailures tftypes.Bool     `tfsdk:"has_active_failures"`
+	LastJobStatus     tftypes.String   `tfsdk:"last_job_status"`
+	HostFilter        tftypes.String   `tfsdk:"host_filter"`
+	Hosts             []HostsItemModel `tfsdk:"hosts"`
+}
+
+// HostsItemModel maps a single host of the hosts data source.
+type HostsItemModel struct {
+	ID          tftypes.Int64                       `tfsdk:"id"`
+	URL         tftypes.String                      `tfsdk:"url"`
+	Name        tftypes.String                      `tfsdk:"name"`
+	Description tftypes.String                      `tfsdk:"description"`
+	Enabled     tftypes.Bool                        `tfsdk:"enabled"`
+	InventoryID tftypes.Int64                       `tfsdk:"inventory_id"`
+	Variables   customtypes.AAPCustomVariablesValue `tfsdk:"variables"`
+	Groups      tftypes.Set                         `tfsdk:"groups"`
+}
+
+// HostsDataSource is the data source implementation.
+type HostsDataSource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &HostsDataSource{}
+	_ datasource.DataSourceWithConfigure = &HostsDataSource{}
+)
+
+// NewHostsDataSource is a helper function to simplify the provider implementation.
+func NewHostsDataSource() datasource.DataSource {
+	return &HostsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *HostsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hosts"
+}
+
+// Schema defines the schema fields for the data source.
+func (d *HostsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"inventory_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Identifier for the inventory to list the hosts from. If not provided, hosts from all inventories are listed.",
+			},
+			"name_contains": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list hosts whose name contains this value, case insensitive.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list hosts that are enabled (`true`) or disabled (`false`).",
+			},
+			"group_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Only list hosts that are direct members of the group with this identifier.",
+			},
+			"has_active_failures": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list hosts whose last job failed (`true`) or did not fail (`false`).",
+			},
+			"last_job_status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list hosts whose last job has this status, for example `successful` or `failed`.",
+			},
+			"host_filter": schema.StringAttribute{
+				Optional: true,
+				Description: "Filter the hosts with the AAP host filter syntax, " +
+					"for example `name=localhost and groups__name=webservers`.",
+			},
+			"hosts": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The list of hosts matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Host id",
+						},
+						"url": schema.StringAttribute{
+							Computed:    true,
+							Description: "URL of the Host",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the Host",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the Host",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the Host is enabled",
+						},
+						"inventory_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Identifier for the inventory the Host belongs to",
+						},
+						"variables": schema.StringAttribute{
+							Computed:   true,
+							CustomType: customtypes.AAPCustomVariablesType{},
+							Description: "Variables of the Host. Will be either JSON or YAML string depending on how the " +
+								"variables were entered into AAP.",
+						},
+						"groups": schema.SetAttribute{
+							Computed:    true,
+							ElementType: tftypes.Int64Type,
+							Description: "The identifiers of the groups the Host is a direct member of.",
+						},
+					},
+				},
+			},
+		},
+		Description: "Get the list of hosts matching the provided filters.",
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *HostsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *HostsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state HostsDataSourceModel
+
+	// Check Read preconditions
+	if !DoReadPreconditionsMeet(ctx, resp, d.client) {
+		return
+	}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostsURL := path.Join(d.client.getAPIEndpoint(), "hosts")
+	if IsValueProvided(state.InventoryID) {
+		hostsURL = path.Join(d.client.getAPIEndpoint(), "inventories", strconv.FormatInt(state.InventoryID.ValueInt64(), 10), "hosts")
+	}
+
+	hosts, diags := d.ListHosts(ctx, hostsURL, state.QueryParams())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(state.ParseHosts(ctx, hosts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// QueryParams returns the server side filters of the host list request.
+func (d *HostsDataSourceModel) QueryParams() map[string]string {
+	params := map[string]string{
+		"order_by":  "id",
+		"page_size": strconv.Itoa(hostsPageSize),
+	}
+	if IsValueProvided(d.NameContains) {
+		params["name__icontains"] = d.NameContains.ValueString()
+	}
+	if IsValueProvided(d.Enabled) {
+		params["enabled"] = strconv.FormatBool(d.Enabled.ValueBool())
+	}
+	if IsValueProvided(d.GroupID) {
+		params["groups__id"] = strconv.FormatInt(d.GroupID.ValueInt64(), 10)
+	}
+	if IsValueProvided(d.HasActiveFailures) {
+		params["has_active_failures"] = strconv.FormatBool(d.HasActiveFailures.ValueBool())
+	}
+	if IsValueProvided(d.LastJobStatus) {
+		params["last_job__status"] = d.LastJobStatus.ValueString()
+	}
+	if IsValueProvided(d.HostFilter) {
+		params["host_filter"] = d.HostFilter.ValueString()
+	}
+	return params
+}
+
+// ListHosts retrieves all the pages of the host list. The first page is used to compute the number
+// of pages, the remaining pages and the truncated groups of the hosts are then fetched in parallel.
+func (d *HostsDataSource) ListHosts(ctx context.Context, url string, params map[string]string) ([]HostListItemAPIModel, diag.Diagnostics) {
+	firstPage, diags := d.getHostsPage(url, params, 1)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if firstPage.Count == 0 {
+		return []HostListItemAPIModel{}, diags
+	}
+
+	pageCount := int((firstPage.Count + hostsPageSize - 1) / hostsPageSize)
+	pages := make([][]HostListItemAPIModel, pageCount+1)
+	pages[1] = firstPage.Results
+	tflog.Debug(ctx, "Listing hosts", map[string]any{"url": url, "count": firstPage.Count, "pages": pageCount})
+
+	diags.Append(forEachParallel(pageCount-1, func(i int) diag.Diagnostics {
+		page := i + 2
+		hostsPage, pageDiags := d.getHostsPage(url, params, page)
+		pages[page] = hostsPage.Results
+		return pageDiags
+	})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	hosts := make([]HostListItemAPIModel, 0, firstPage.Count)
+	for _, page := range pages {
+		hosts = append(hosts, page...)
+	}
+
+	// The summary fields only include the first groups of a host, retrieve the complete list when truncated.
+	var truncated []int
+	for i := range hosts {
+		if int64(len(hosts[i].SummaryFields.Groups.Results)) < hosts[i].SummaryFields.Groups.Count {
+			truncated = append(truncated, i)
+		}
+	}
+	diags.Append(forEachParallel(len(truncated), func(i int) diag.Diagnostics {
+		host := &hosts[truncated[i]]
+		groups, groupDiags := d.listHostGroups(ctx, host.ID)
+		if !groupDiags.HasError() {
+			host.SummaryFields.Groups.Results = groups
+		}
+		return groupDiags
+	})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return hosts, diags
+}
+
+// forEachParallel calls fn for each index from 0 to count-1, running at most hostsMaxParallelRequests
+// calls concurrently. The remaining calls are skipped once a call returns an error. Each call must only
+// write to data owned by its index.
+func forEachParallel(count int, fn func(i int) diag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	semaphore := make(chan struct{}, hostsMaxParallelRequests)
+
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			// Check if any error occurred in any other goroutines
+			mu.Lock()
+			failed := diags.HasError()
+			mu.Unlock()
+			if failed {
+				return
+			}
+
+			callDiags := fn(i)
+
+			mu.Lock()
+			defer mu.Unlock()
+			diags.Append(callDiags...)
+		}(i)
+	}
+
+	// Wait for all goroutines to finish
+	wg.Wait()
+	return diags
+}
+
+// getHostsPage retrieves a single page of the host list.
+func (d *HostsDataSource) getHostsPage(url string, params map[string]string, page int) (HostListAPIModel, diag.Diagnostics) {
+	var hostsPage HostListAPIModel
+
+	pageParams := make(map[string]string, len(params)+1)
+	for k, v := range params {
+		pageParams[k] = v
+	}
+	pageParams["page"] = strconv.Itoa(page)
+
+	body, diags := d.client.GetWithParams(url, pageParams)
+	if diags.HasError() {
+		return hostsPage, diags
+	}
+
+	err := json.Unmarshal(body, &hostsPage)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+	}
+	return hostsPage, diags
+}
+
+// listHostGroups retrieves all the groups a host is a direct member of.
+func (d *HostsDataSource) listHostGroups(ctx context.Context, hostID int64) ([]SummaryField, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var groups []SummaryField
+
+	url := path.Join(d.client.getAPIEndpoint(), "hosts", strconv.FormatInt(hostID, 10), "groups")
+	for page := 1; ; page++ {
+		if !IsContextActive(ctx, "Read", &diags) {
+			return nil, diags
+		}
+
+		body, pageDiags := d.client.GetWithParams(url, map[string]string{
+			"page":      strconv.Itoa(page),
+			"page_size": strconv.Itoa(hostsPageSize),
+		})
+		diags.Append(pageDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var groupsPage struct {
+			Next    string         `json:"next"`
+			Results []SummaryField `json:"results"`
+		}
+		err := json.Unmarshal(body, &groupsPage)
+		if err != nil {
+			diags.AddError("Error parsing JSON response from AAP", err.Error())
+			return nil, diags
+		}
+		groups = append(groups, groupsPage.Results...)
+
+		if groupsPage.Next == "" {
+			return groups, diags
+		}
+	}
+}
+
+// ParseHosts updates the data source model with the hosts retrieved from AAP.
+func (d *HostsDataSourceModel) ParseHosts(ctx context.Context, hosts []HostListItemAPIModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.Hosts = make([]HostsItemModel, 0, len(hosts))
+	for _, host := range hosts {
+		groupIDs := make([]int64, 0, len(host.SummaryFields.Groups.Results))
+		for _, group := range host.SummaryFields.Groups.Results {
+			groupIDs = append(groupIDs, group.ID)
+		}
+		groups, groupDiags := tftypes.SetValueFrom(ctx, tftypes.Int64Type, groupIDs)
+		diags.Append(groupDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		d.Hosts = append(d.Hosts, HostsItemModel{
+			ID:          tftypes.Int64Value(host.ID),
+			URL:         tftypes.StringValue(host.URL),
+			Name:        tftypes.StringValue(host.Name),
+			Description: ParseStringValue(host.Description),
+			Enabled:     tftypes.BoolValue(host.Enabled),
+			InventoryID: tftypes.Int64Value(host.Inventory),
+			Variables:   ParseAAPCustomVariablesValue(host.Variables),
+			Groups:      groups,
+		})
+	}
+
+	return diags
+}
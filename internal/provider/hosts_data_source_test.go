@@ -0,0 +1,396 @@
+package provider
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHostsDataSourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	schemaRequest := datasource.SchemaRequest{}
+	schemaResponse := &datasource.SchemaResponse{}
+
+	// Instantiate the HostsDataSource and call its Schema method
+	NewHostsDataSource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestHostsDataSourceQueryParams(t *testing.T) {
+	defaultParams := map[string]string{"order_by": "id", "page_size": "200"}
+	withDefaults := func(params map[string]string) map[string]string {
+		result := maps.Clone(defaultParams)
+		maps.Copy(result, params)
+		return result
+	}
+
+	var testTable = []struct {
+		name     string
+		input    HostsDataSourceModel
+		expected map[string]string
+	}{
+		{
+			name: "no filters",
+			input: HostsDataSourceModel{
+				InventoryID:       tftypes.Int64Value(1),
+				NameContains:      tftypes.StringNull(),
+				Enabled:           tftypes.BoolNull(),
+				GroupID:           tftypes.Int64Null(),
+				HasActiveFailures: tftypes.BoolNull(),
+				LastJobStatus:     tftypes.StringNull(),
+				HostFilter:        tftypes.StringNull(),
+			},
+			expected: defaultParams,
+		},
+		{
+			name: "all filters",
+			input: HostsDataSourceModel{
+				InventoryID:       tftypes.Int64Null(),
+				NameContains:      tftypes.StringValue("web"),
+				Enabled:           tftypes.BoolValue(false),
+				GroupID:           tftypes.Int64Value(4),
+				HasActiveFailures: tftypes.BoolValue(true),
+				LastJobStatus:     tftypes.StringValue("failed"),
+				HostFilter:        tftypes.StringValue("groups__name=webservers"),
+			},
+			expected: withDefaults(map[string]string{
+				"name__icontains":     "web",
+				"enabled":             "false",
+				"groups__id":          "4",
+				"has_active_failures": "true",
+				"last_job__status":    "failed",
+				"host_filter":         "groups__name=webservers",
+			}),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual := test.input.QueryParams()
+			if !reflect.DeepEqual(test.expected, actual) {
+				t.Errorf("Expected (%v) not equal to actual (%v)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestHostsDataSourceListHosts(t *testing.T) {
+	hostsURL := "/api/v2/inventories/1/hosts"
+	params := map[string]string{"order_by": "id", "page_size": "200"}
+	pageParams := func(page string) map[string]string {
+		result := maps.Clone(params)
+		result["page"] = page
+		return result
+	}
+
+	t.Run("fetches all pages in order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := NewMockProviderHTTPClient(ctrl)
+		mockClient.EXPECT().GetWithParams(hostsURL, pageParams("1")).Return(
+			[]byte(`{"count":401,"results":[{"id":1,"name":"host1","summary_fields":{"groups":{"count":0,"results":[]}}}]}`),
+			diag.Diagnostics{},
+		)
+		mockClient.EXPECT().GetWithParams(hostsURL, pageParams("2")).Return(
+			[]byte(`{"count":401,"results":[{"id":2,"name":"host2","summary_fields":{"groups":{"count":1,"results":[{"id":7,"name":"g"}]}}}]}`),
+			diag.Diagnostics{},
+		)
+		mockClient.EXPECT().GetWithParams(hostsURL, pageParams("3")).Return(
+			[]byte(`{"count":401,"results":[{"id":3,"name":"host3","summary_fields":{"groups":{"count":0,"results":[]}}}]}`),
+			diag.Diagnostics{},
+		)
+
+		d := HostsDataSource{client: mockClient}
+		hosts, diags := d.ListHosts(t.Context(), hostsURL, params)
+		if diags.HasError() {
+			t.Fatal(diags.Errors())
+		}
+		if len(hosts) != 3 {
+			t.Fatalf("Expected 3 hosts, got %d", len(hosts))
+		}
+		for i, host := range hosts {
+			if host.ID != int64(i+1) {
+				t.Errorf("Expected host %d at position %d, got %d", i+1, i, host.ID)
+			}
+		}
+	})
+
+	t.Run("returns no hosts when none match", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := NewMockProviderHTTPClient(ctrl)
+		mockClient.EXPECT().GetWithParams(hostsURL, pageParams("1")).Return([]byte(`{"count":0,"results":[]}`), diag.Diagnostics{})
+
+		d := HostsDataSource{client: mockClient}
+		hosts, diags := d.ListHosts(t.Context(), hostsURL, params)
+		if diags.HasError() {
+			t.Fatal(diags.Errors())
+		}
+		if hosts == nil || len(hosts) != 0 {
+			t.Fatalf("Expected an empty list of hosts, got %v", hosts)
+		}
+	})
+
+	t.Run("retrieves truncated groups", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := NewMockProviderHTTPClient(ctrl)
+		mockClient.EXPECT().GetWithParams(hostsURL, pageParams("1")).Return(
+			[]byte(`{"count":1,"results":[{"id":1,"name":"host1","summary_fields":{"groups":{"count":3,"results":[{"id":1,"name":"a"}]}}}]}`),
+			diag.Diagnostics{},
+		)
+		mockClient.EXPECT().getAPIEndpoint().Return("/api/v2")
+		mockClient.EXPECT().GetWithParams("/api/v2/hosts/1/groups", map[string]string{"page": "1", "page_size": "200"}).Return(
+			[]byte(`{"next":"/api/v2/hosts/1/groups/?page=2","results":[{"id":1,"name":"a"},{"id":2,"name":"b"}]}`),
+			diag.Diagnostics{},
+		)
+		mockClient.EXPECT().GetWithParams("/api/v2/hosts/1/groups", map[string]string{"page": "2", "page_size": "200"}).Return(
+			[]byte(`{"next":null,"results":[{"id":3,"name":"c"}]}`),
+			diag.Diagnostics{},
+		)
+
+		d := HostsDataSource{client: mockClient}
+		hosts, diags := d.ListHosts(t.Context(), hostsURL, params)
+		if diags.HasError() {
+			t.Fatal(diags.Errors())
+		}
+		expected := []SummaryField{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}, {ID: 3, Name: "c"}}
+		if !reflect.DeepEqual(expected, hosts[0].SummaryFields.Groups.Results) {
+			t.Errorf("Expected (%v) not equal to actual (%v)", expected, hosts[0].SummaryFields.Groups.Results)
+		}
+	})
+
+	t.Run("only retrieves the groups of truncated hosts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := NewMockProviderHTTPClient(ctrl)
+		mockClient.EXPECT().GetWithParams(hostsURL, pageParams("1")).Return(
+			[]byte(`{"count":3,"results":[`+
+				`{"id":1,"name":"host1","summary_fields":{"groups":{"count":2,"results":[{"id":1,"name":"a"}]}}},`+
+				`{"id":2,"name":"host2","summary_fields":{"groups":{"count":1,"results":[{"id":1,"name":"a"}]}}},`+
+				`{"id":3,"name":"host3","summary_fields":{"groups":{"count":2,"results":[{"id":2,"name":"b"}]}}}]}`),
+			diag.Diagnostics{},
+		)
+		mockClient.EXPECT().getAPIEndpoint().Return("/api/v2").Times(2)
+		mockClient.EXPECT().GetWithParams("/api/v2/hosts/1/groups", map[string]string{"page": "1", "page_size": "200"}).Return(
+			[]byte(`{"next":null,"results":[{"id":1,"name":"a"},{"id":3,"name":"c"}]}`),
+			diag.Diagnostics{},
+		)
+		mockClient.EXPECT().GetWithParams("/api/v2/hosts/3/groups", map[string]string{"page": "1", "page_size": "200"}).Return(
+			[]byte(`{"next":null,"results":[{"id":2,"name":"b"},{"id":3,"name":"c"}]}`),
+			diag.Diagnostics{},
+		)
+
+		d := HostsDataSource{client: mockClient}
+		hosts, diags := d.ListHosts(t.Context(), hostsURL, params)
+		if diags.HasError() {
+			t.Fatal(diags.Errors())
+		}
+		expected := [][]SummaryField{
+			{{ID: 1, Name: "a"}, {ID: 3, Name: "c"}},
+			{{ID: 1, Name: "a"}},
+			{{ID: 2, Name: "b"}, {ID: 3, Name: "c"}},
+		}
+		for i, host := range hosts {
+			if !reflect.DeepEqual(expected[i], host.SummaryFields.Groups.Results) {
+				t.Errorf("Expected (%v) not equal to actual (%v)", expected[i], host.SummaryFields.Groups.Results)
+			}
+		}
+	})
+
+	t.Run("returns page errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		errorDiags := diag.Diagnostics{}
+		errorDiags.AddError("Client request error", "page 2 failed")
+
+		mockClient := NewMockProviderHTTPClient(ctrl)
+		mockClient.EXPECT().GetWithParams(hostsURL, pageParams("1")).Return(
+			[]byte(`{"count":201,"results":[{"id":1,"name":"host1"}]}`),
+			diag.Diagnostics{},
+		)
+		mockClient.EXPECT().GetWithParams(hostsURL, pageParams("2")).Return(nil, errorDiags)
+
+		d := HostsDataSource{client: mockClient}
+		_, diags := d.ListHosts(t.Context(), hostsURL, params)
+		if !diags.Equal(errorDiags) {
+			t.Errorf("Expected error diagnostics (%s), actual was (%s)", errorDiags, diags)
+		}
+	})
+}
+
+func TestForEachParallel(t *testing.T) {
+	t.Run("calls each index with bounded concurrency", func(t *testing.T) {
+		var mu sync.Mutex
+		running, maxRunning := 0, 0
+		called := make([]bool, 20)
+
+		diags := forEachParallel(len(called), func(i int) diag.Diagnostics {
+			mu.Lock()
+			running++
+			maxRunning = max(maxRunning, running)
+			mu.Unlock()
+
+			time.Sleep(time.Millisecond)
+			called[i] = true
+
+			mu.Lock()
+			running--
+			mu.Unlock()
+			return nil
+		})
+		if diags.HasError() {
+			t.Fatal(diags.Errors())
+		}
+		if maxRunning > hostsMaxParallelRequests {
+			t.Errorf("Expected at most %d concurrent calls, got %d", hostsMaxParallelRequests, maxRunning)
+		}
+		for i, ok := range called {
+			if !ok {
+				t.Errorf("Expected index %d to be called", i)
+			}
+		}
+	})
+
+	t.Run("returns errors", func(t *testing.T) {
+		errorDiags := diag.Diagnostics{}
+		errorDiags.AddError("Client request error", "failed")
+
+		diags := forEachParallel(1, func(_ int) diag.Diagnostics {
+			return errorDiags
+		})
+		if !diags.Equal(errorDiags) {
+			t.Errorf("Expected error diagnostics (%s), actual was (%s)", errorDiags, diags)
+		}
+	})
+}
+
+func TestHostsDataSourceParseHosts(t *testing.T) {
+	state := HostsDataSourceModel{}
+	diags := state.ParseHosts(t.Context(), []HostListItemAPIModel{
+		{
+			ID:        1,
+			URL:       "/api/v2/hosts/1/",
+			Name:      "host1",
+			Enabled:   true,
+			Inventory: 2,
+			Variables: "{\"foo\": \"bar\"}",
+			SummaryFields: HostListSummaryFieldsAPIModel{
+				Groups: HostGroupsSummaryAPIModel{Count: 2, Results: []SummaryField{{ID: 3}, {ID: 4}}},
+			},
+		},
+	})
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+
+	groups, _ := tftypes.SetValueFrom(t.Context(), tftypes.Int64Type, []int64{3, 4})
+	expected := HostsItemModel{
+		ID:          tftypes.Int64Value(1),
+		URL:         tftypes.StringValue("/api/v2/hosts/1/"),
+		Name:        tftypes.StringValue("host1"),
+		Description: tftypes.StringNull(),
+		Enabled:     tftypes.BoolValue(true),
+		InventoryID: tftypes.Int64Value(2),
+		Variables:   ParseAAPCustomVariablesValue("{\"foo\": \"bar\"}"),
+		Groups:      groups,
+	}
+	if len(state.Hosts) != 1 || !reflect.DeepEqual(expected, state.Hosts[0]) {
+		t.Errorf("Expected (%v) not equal to actual (%v)", expected, state.Hosts)
+	}
+}
+
+func TestAccHostsDataSource(t *testing.T) {
+	inventoryName := "test-inventory-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	hostName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostsDataSource(inventoryName, hostName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aap_hosts.all", "hosts.#", "2"),
+					resource.TestCheckResourceAttr("data.aap_hosts.filtered", "hosts.#", "1"),
+					resource.TestCheckResourceAttr("data.aap_hosts.filtered", "hosts.0.name", hostName+"-web"),
+					resource.TestCheckResourceAttr("data.aap_hosts.filtered", "hosts.0.enabled", "true"),
+					resource.TestCheckResourceAttr("data.aap_hosts.filtered", "hosts.0.groups.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aap_hosts.filtered", "hosts.0.groups.0", "aap_group.test", "id"),
+					resource.TestCheckResourceAttr("data.aap_hosts.disabled", "hosts.#", "1"),
+					resource.TestCheckResourceAttr("data.aap_hosts.disabled", "hosts.0.name", hostName+"-db"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHostsDataSource(inventoryName, hostName string) string {
+	return fmt.Sprintf(`
+resource "aap_inventory" "test" {
+  name = "%[1]s"
+}
+
+resource "aap_group" "test" {
+  name         = "webservers"
+  inventory_id = aap_inventory.test.id
+}
+
+resource "aap_host" "web" {
+  name         = "%[2]s-web"
+  inventory_id = aap_inventory.test.id
+  groups       = [aap_group.test.id]
+}
+
+resource "aap_host" "db" {
+  name         = "%[2]s-db"
+  inventory_id = aap_inventory.test.id
+  enabled      = false
+}
+
+data "aap_hosts" "all" {
+  inventory_id = aap_inventory.test.id
+  depends_on   = [aap_host.web, aap_host.db]
+}
+
+data "aap_hosts" "filtered" {
+  inventory_id  = aap_inventory.test.id
+  name_contains = "-WEB"
+  group_id      = aap_group.test.id
+  depends_on    = [aap_host.web, aap_host.db]
+}
+
+data "aap_hosts" "disabled" {
+  inventory_id = aap_inventory.test.id
+  enabled      = false
+  depends_on   = [aap_host.web, aap_host.db]
+}
+`, inventoryName, hostName)
+}
@@ -1,17 +1,68 @@
 package provider
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// inventoryScriptMetaKey is the key of the inventory script holding the host variables.
+const inventoryScriptMetaKey = "_meta"
+
 // InventoryAPIModel represents an Inventory AAP API model
 type InventoryAPIModel struct {
 	BaseDetailAPIModelWithOrg
 }
 
+// InventoryScriptGroupAPIModel represents a group of the AAP API inventory script.
+// /api/controller/v2/inventories/<id>/script/
+type InventoryScriptGroupAPIModel struct {
+	Hosts    []string       `json:"hosts"`
+	Children []string       `json:"children"`
+	Vars     map[string]any `json:"vars"`
+}
+
 // InventoryDataSourceModel maps the data source schema data.
 type InventoryDataSourceModel struct {
 	BaseDetailSourceModelWithOrg
+	IncludeContents tftypes.Bool   `tfsdk:"include_contents"`
+	Hosts           tftypes.Map    `tfsdk:"hosts"`
+	Groups          tftypes.Map    `tfsdk:"groups"`
+	Script          tftypes.String `tfsdk:"script"`
+}
+
+// InventoryHostContentsModel maps a host of the inventory data source.
+type InventoryHostContentsModel struct {
+	Variables tftypes.String `tfsdk:"variables"`
+	Groups    []string       `tfsdk:"groups"`
+}
+
+// InventoryGroupContentsModel maps a group of the inventory data source.
+type InventoryGroupContentsModel struct {
+	Variables tftypes.String `tfsdk:"variables"`
+	Hosts     []string       `tfsdk:"hosts"`
+	Children  []string       `tfsdk:"children"`
+}
+
+// inventoryHostContentsAttrTypes are the attribute types of InventoryHostContentsModel.
+var inventoryHostContentsAttrTypes = map[string]attr.Type{
+	"variables": tftypes.StringType,
+	"groups":    tftypes.ListType{ElemType: tftypes.StringType},
+}
+
+// inventoryGroupContentsAttrTypes are the attribute types of InventoryGroupContentsModel.
+var inventoryGroupContentsAttrTypes = map[string]attr.Type{
+	"variables": tftypes.StringType,
+	"hosts":     tftypes.ListType{ElemType: tftypes.StringType},
+	"children":  tftypes.ListType{ElemType: tftypes.StringType},
 }
 
 // InventoryDataSource is the data source implementation.
@@ -29,3 +80,224 @@ func NewInventoryDataSource() datasource.DataSource {
 		}),
 	}
 }
+
+// Schema defines the schema fields for the inventory data source, extending the base schema with the
+// inventory contents.
+func (d *InventoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	d.BaseDataSourceWithOrg.Schema(ctx, req, resp)
+
+	resp.Schema.Attributes["include_contents"] = schema.BoolAttribute{
+		Optional: true,
+		Description: "When set to `true`, the hosts, groups and script of the inventory are retrieved. " +
+			"Defaults to `false`, as rendering a large inventory is expensive.",
+	}
+	resp.Schema.Attributes["hosts"] = schema.MapNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"variables": schema.StringAttribute{
+					Computed:    true,
+					Description: "Variables of the host, as a JSON string",
+				},
+				"groups": schema.ListAttribute{
+					ElementType: tftypes.StringType,
+					Computed:    true,
+					Description: "Names of the groups the host is a direct member of",
+				},
+			},
+		},
+		Description: "Enabled hosts of the inventory, keyed by host name. Only set when `include_contents` is `true`.",
+	}
+	resp.Schema.Attributes["groups"] = schema.MapNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"variables": schema.StringAttribute{
+					Computed:    true,
+					Description: "Variables of the group, as a JSON string",
+				},
+				"hosts": schema.ListAttribute{
+					ElementType: tftypes.StringType,
+					Computed:    true,
+					Description: "Names of the enabled hosts that are direct members of the group",
+				},
+				"children": schema.ListAttribute{
+					ElementType: tftypes.StringType,
+					Computed:    true,
+					Description: "Names of the child groups of the group",
+				},
+			},
+		},
+		Description: "Groups of the inventory, keyed by group name, including the `all` and `ungrouped` groups " +
+			"rendered by AAP. Only set when `include_contents` is `true`.",
+	}
+	resp.Schema.Attributes["script"] = schema.StringAttribute{
+		Computed: true,
+		Description: "The inventory rendered by AAP as a JSON string with host variables, " +
+			"as returned by the inventory script endpoint. Only set when `include_contents` is `true`.",
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *InventoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state InventoryDataSourceModel
+
+	// Check Read preconditions
+	if !DoReadPreconditionsMeet(ctx, resp, d.client) {
+		return
+	}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(d.ReadNamedEntity(&state.BaseDetailSourceModelWithOrg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Hosts = tftypes.MapNull(tftypes.ObjectType{AttrTypes: inventoryHostContentsAttrTypes})
+	state.Groups = tftypes.MapNull(tftypes.ObjectType{AttrTypes: inventoryGroupContentsAttrTypes})
+	state.Script = tftypes.StringNull()
+	if state.IncludeContents.ValueBool() {
+		scriptURL := path.Join(state.URL.ValueString(), "script")
+		scriptResponseBody, diags := d.client.GetWithParams(scriptURL, map[string]string{"hostvars": "1"})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(state.ParseInventoryScript(ctx, scriptResponseBody)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ParseInventoryScript updates the hosts, groups and script of the inventory data source from the AAP API
+// inventory script.
+func (d *InventoryDataSourceModel) ParseInventoryScript(ctx context.Context, body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Numbers are decoded as such, so that large integers in variables are not rounded
+	var script map[string]json.RawMessage
+	err := json.Unmarshal(body, &script)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	var meta struct {
+		HostVars map[string]map[string]any `json:"hostvars"`
+	}
+	if raw, ok := script[inventoryScriptMetaKey]; ok {
+		if err := unmarshalUseNumber(raw, &meta); err != nil {
+			diags.AddError("Error parsing JSON response from AAP", err.Error())
+			return diags
+		}
+	}
+
+	hosts := map[string]InventoryHostContentsModel{}
+	addHost := func(name string) InventoryHostContentsModel {
+		host, ok := hosts[name]
+		if !ok {
+			variables, encodeDiags := encodeVariables(meta.HostVars[name])
+			diags.Append(encodeDiags...)
+			host = InventoryHostContentsModel{
+				Variables: tftypes.StringValue(variables),
+				Groups:    []string{},
+			}
+		}
+		return host
+	}
+	for name := range meta.HostVars {
+		hosts[name] = addHost(name)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	groups := map[string]InventoryGroupContentsModel{}
+	for name, raw := range script {
+		if name == inventoryScriptMetaKey {
+			continue
+		}
+
+		var apiGroup InventoryScriptGroupAPIModel
+		if err := unmarshalUseNumber(raw, &apiGroup); err != nil {
+			diags.AddError("Error parsing JSON response from AAP", err.Error())
+			return diags
+		}
+
+		variables, encodeDiags := encodeVariables(apiGroup.Vars)
+		diags.Append(encodeDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		group := InventoryGroupContentsModel{
+			Variables: tftypes.StringValue(variables),
+			Hosts:     append([]string{}, apiGroup.Hosts...),
+			Children:  append([]string{}, apiGroup.Children...),
+		}
+		slices.Sort(group.Hosts)
+		slices.Sort(group.Children)
+		groups[name] = group
+
+		for _, hostName := range apiGroup.Hosts {
+			host := addHost(hostName)
+			host.Groups = append(host.Groups, name)
+			hosts[hostName] = host
+		}
+	}
+	for name, host := range hosts {
+		slices.Sort(host.Groups)
+		hosts[name] = host
+	}
+
+	var valueDiags diag.Diagnostics
+	d.Hosts, valueDiags = tftypes.MapValueFrom(ctx, tftypes.ObjectType{AttrTypes: inventoryHostContentsAttrTypes}, hosts)
+	diags.Append(valueDiags...)
+	d.Groups, valueDiags = tftypes.MapValueFrom(ctx, tftypes.ObjectType{AttrTypes: inventoryGroupContentsAttrTypes}, groups)
+	diags.Append(valueDiags...)
+
+	// Re-encode the script so that its keys are sorted and its value is stable across reads
+	var contents any
+	if err := unmarshalUseNumber(body, &contents); err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+	rendered, err := json.Marshal(contents)
+	if err != nil {
+		diags.AddError("Error encoding the inventory script", err.Error())
+		return diags
+	}
+	d.Script = tftypes.StringValue(string(rendered))
+
+	return diags
+}
+
+// unmarshalUseNumber decodes the JSON data into the value, decoding numbers as json.Number.
+func unmarshalUseNumber(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+// encodeVariables encodes the variables as a JSON string, with sorted keys.
+func encodeVariables(variables map[string]any) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if variables == nil {
+		return "{}", diags
+	}
+	encoded, err := json.Marshal(variables)
+	if err != nil {
+		diags.AddError("Error encoding the variables as JSON", err.Error())
+		return "", diags
+	}
+	return string(encoded), diags
+}
@@ -1,7 +1,9 @@
 package provider
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
@@ -61,7 +63,7 @@ func TestInventoryDataSourceParseHTTPResponse(t *testing.T) {
 						Description: tftypes.StringNull(),
 						Name:        tftypes.StringNull(),
 						NamedURL:    tftypes.StringNull(),
-						Variables:   customtypes.NewAAPCustomStringNull()},
+						Variables:   customtypes.NewAAPCustomVariablesNull()},
 					Organization:     tftypes.Int64Value(2),
 					OrganizationName: tftypes.StringNull(),
 				},
@@ -81,7 +83,7 @@ func TestInventoryDataSourceParseHTTPResponse(t *testing.T) {
 						Description: tftypes.StringValue("My Test Inventory"),
 						Name:        tftypes.StringValue("my inventory"),
 						NamedURL:    tftypes.StringNull(),
-						Variables:   customtypes.NewAAPCustomStringValue("{\"foo\":\"bar\"}"),
+						Variables:   customtypes.NewAAPCustomVariablesValue("{\"foo\":\"bar\"}"),
 					},
 					Organization:     tftypes.Int64Value(2),
 					OrganizationName: tftypes.StringNull(),
@@ -98,13 +100,97 @@ func TestInventoryDataSourceParseHTTPResponse(t *testing.T) {
 			if !test.errors.Equal(diags) {
 				t.Errorf("Expected error diagnostics (%s), Received (%s)", test.errors, diags)
 			}
-			if test.expected != resource {
+			if !reflect.DeepEqual(test.expected, resource) {
 				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, resource)
 			}
 		})
 	}
 }
 
+func TestInventoryDataSourceParseInventoryScript(t *testing.T) {
+	ctx := t.Context()
+	body := []byte(`{
+		"all": {"children": ["ungrouped", "web"], "vars": {"env": "prod"}},
+		"web": {"hosts": ["web2", "web1"], "children": ["db"], "vars": {"port": 8080}},
+		"db": {"hosts": ["web1"]},
+		"ungrouped": {"hosts": ["lonely"]},
+		"_meta": {"hostvars": {"web1": {"id": 12345678901234567890}, "web2": {}, "lonely": {"a": "b"}}}
+	}`)
+
+	var data InventoryDataSourceModel
+	diags := data.ParseInventoryScript(ctx, body)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags.Errors())
+	}
+
+	var hosts map[string]InventoryHostContentsModel
+	var groups map[string]InventoryGroupContentsModel
+	diags.Append(data.Hosts.ElementsAs(ctx, &hosts, false)...)
+	diags.Append(data.Groups.ElementsAs(ctx, &groups, false)...)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags.Errors())
+	}
+
+	expectedHosts := map[string]InventoryHostContentsModel{
+		"web1":   {Variables: tftypes.StringValue(`{"id":12345678901234567890}`), Groups: []string{"db", "web"}},
+		"web2":   {Variables: tftypes.StringValue(`{}`), Groups: []string{"web"}},
+		"lonely": {Variables: tftypes.StringValue(`{"a":"b"}`), Groups: []string{"ungrouped"}},
+	}
+	if !reflect.DeepEqual(expectedHosts, hosts) {
+		t.Errorf("Expected hosts (%v), got (%v)", expectedHosts, hosts)
+	}
+
+	expectedGroups := map[string]InventoryGroupContentsModel{
+		"all":       {Variables: tftypes.StringValue(`{"env":"prod"}`), Hosts: []string{}, Children: []string{"ungrouped", "web"}},
+		"web":       {Variables: tftypes.StringValue(`{"port":8080}`), Hosts: []string{"web1", "web2"}, Children: []string{"db"}},
+		"db":        {Variables: tftypes.StringValue(`{}`), Hosts: []string{"web1"}, Children: []string{}},
+		"ungrouped": {Variables: tftypes.StringValue(`{}`), Hosts: []string{"lonely"}, Children: []string{}},
+	}
+	if !reflect.DeepEqual(expectedGroups, groups) {
+		t.Errorf("Expected groups (%v), got (%v)", expectedGroups, groups)
+	}
+
+	expectedScript := tftypes.StringValue(`{"_meta":{"hostvars":{"lonely":{"a":"b"},"web1":{"id":12345678901234567890},"web2":{}}},` +
+		`"all":{"children":["ungrouped","web"],"vars":{"env":"prod"}},"db":{"hosts":["web1"]},` +
+		`"ungrouped":{"hosts":["lonely"]},"web":{"children":["db"],"hosts":["web2","web1"],"vars":{"port":8080}}}`)
+	if !data.Script.Equal(expectedScript) {
+		t.Errorf("Expected script (%s), got (%s)", expectedScript, data.Script)
+	}
+}
+
+func TestInventoryDataSourceParseInventoryScriptError(t *testing.T) {
+	var data InventoryDataSourceModel
+	diags := data.ParseInventoryScript(t.Context(), []byte(`{"web": {"hosts": "not a list"}}`))
+	if !diags.HasError() {
+		t.Error("Expected an error parsing an invalid inventory script")
+	}
+}
+
+func TestEncodeVariables(t *testing.T) {
+	var testTable = []struct {
+		name        string
+		variables   map[string]any
+		expected    string
+		expectError bool
+	}{
+		{name: "no variables", variables: nil, expected: "{}"},
+		{name: "sorted keys", variables: map[string]any{"b": json.Number("1"), "a": "x"}, expected: `{"a":"x","b":1}`},
+		{name: "invalid number", variables: map[string]any{"a": json.Number("not a number")}, expectError: true},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			encoded, diags := encodeVariables(test.variables)
+			if test.expectError != diags.HasError() {
+				t.Fatalf("Expected error (%t), got (%v)", test.expectError, diags.Errors())
+			}
+			if encoded != test.expected {
+				t.Errorf("Expected (%s), got (%s)", test.expected, encoded)
+			}
+		})
+	}
+}
+
 func TestAccInventoryDataSource(t *testing.T) {
 	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
@@ -148,6 +234,70 @@ func TestAccInventoryDataSource(t *testing.T) {
 	})
 }
 
+func TestAccInventoryDataSourceContents(t *testing.T) {
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInventoryDataSourceContents(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aap_inventory.test", "hosts.%", "2"),
+					resource.TestCheckResourceAttr("data.aap_inventory.test", "hosts.web1.variables", `{"port":8080}`),
+					resource.TestCheckResourceAttr("data.aap_inventory.test", "hosts.web1.groups.#", "1"),
+					resource.TestCheckResourceAttr("data.aap_inventory.test", "hosts.web1.groups.0", "web"),
+					resource.TestCheckResourceAttr("data.aap_inventory.test", "groups.web.variables", `{"tier":"frontend"}`),
+					resource.TestCheckResourceAttr("data.aap_inventory.test", "groups.web.hosts.#", "1"),
+					resource.TestCheckResourceAttr("data.aap_inventory.test", "groups.ungrouped.hosts.0", "web2"),
+					resource.TestCheckResourceAttrSet("data.aap_inventory.test", "script"),
+					resource.TestCheckNoResourceAttr("data.aap_inventory.without_contents", "script"),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckInventoryResourceDestroy,
+	})
+}
+
+// testAccInventoryDataSourceContents configures the Inventory Data Source with hosts and groups for testing
+func testAccInventoryDataSourceContents(name string) string {
+	return fmt.Sprintf(`
+resource "aap_inventory" "test" {
+  name = "%s"
+}
+
+resource "aap_host" "web1" {
+  name         = "web1"
+  inventory_id = aap_inventory.test.id
+  variables    = jsonencode({ port = 8080 })
+}
+
+resource "aap_host" "web2" {
+  name         = "web2"
+  inventory_id = aap_inventory.test.id
+}
+
+resource "aap_group" "web" {
+  name         = "web"
+  inventory_id = aap_inventory.test.id
+  variables    = jsonencode({ tier = "frontend" })
+  hosts        = [aap_host.web1.id]
+}
+
+data "aap_inventory" "test" {
+  id               = aap_inventory.test.id
+  include_contents = true
+
+  depends_on = [aap_group.web, aap_host.web2]
+}
+
+data "aap_inventory" "without_contents" {
+  id = aap_inventory.test.id
+}
+`, name)
+}
+
 // testAccInventoryDataSource configures the Inventory Data Source for testing
 func testAccInventoryDataSource(name string) string {
 	return fmt.Sprintf(`
@@ -0,0 +1,620 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// bulkHostCreateBatchSize is the maximum number of hosts accepted by a single bulk host create request.
+	bulkHostCreateBatchSize = 100
+	// bulkHostDeleteBatchSize is the maximum number of hosts accepted by a single bulk host delete request.
+	bulkHostDeleteBatchSize = 250
+)
+
+// BulkHostAPIModel represents a host of the AAP API bulk host create request.
+type BulkHostAPIModel struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Enabled     bool   `json:"enabled"`
+	Variables   string `json:"variables,omitempty"`
+}
+
+// BulkHostCreateRequestModel represents the AAP API bulk host create request.
+// /api/controller/v2/bulk/host_create/
+type BulkHostCreateRequestModel struct {
+	Inventory int64              `json:"inventory"`
+	Hosts     []BulkHostAPIModel `json:"hosts"`
+}
+
+// BulkHostCreateResponseModel represents the AAP API bulk host create response.
+type BulkHostCreateResponseModel struct {
+	Hosts []HostListItemAPIModel `json:"hosts"`
+}
+
+// BulkHostDeleteRequestModel represents the AAP API bulk host delete request.
+// /api/controller/v2/bulk/host_delete/
+type BulkHostDeleteRequestModel struct {
+	Hosts []int64 `json:"hosts"`
+}
+
+// InventoryHostsResourceModel maps the inventory hosts resource schema to a Go struct.
+type InventoryHostsResourceModel struct {
+	InventoryID types.Int64 `tfsdk:"inventory_id"`
+	Hosts       types.Set   `tfsdk:"hosts"`
+	HostIDs     types.Map   `tfsdk:"host_ids"`
+}
+
+// InventoryHostModel maps a host of the inventory hosts resource to a Go struct.
+type InventoryHostModel struct {
+	Name        types.String                        `tfsdk:"name"`
+	Description types.String                        `tfsdk:"description"`
+	Enabled     types.Bool                          `tfsdk:"enabled"`
+	Variables   customtypes.AAPCustomVariablesValue `tfsdk:"variables"`
+}
+
+// inventoryHostAttrTypes are the attribute types of a host of the inventory hosts resource.
+var inventoryHostAttrTypes = map[string]attr.Type{
+	"name":        types.StringType,
+	"description": types.StringType,
+	"enabled":     types.BoolType,
+	"variables":   customtypes.AAPCustomVariablesType{},
+}
+
+// InventoryHostsResource is the resource implementation.
+type InventoryHostsResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &InventoryHostsResource{}
+	_ resource.ResourceWithConfigure      = &InventoryHostsResource{}
+	_ resource.ResourceWithValidateConfig = &InventoryHostsResource{}
+)
+
+// NewInventoryHostsResource is a helper function to simplify the provider implementation.
+func NewInventoryHostsResource() resource.Resource {
+	return &InventoryHostsResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *InventoryHostsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inventory_hosts"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *InventoryHostsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *InventoryHostsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"inventory_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "ID of the inventory the hosts belong to",
+			},
+			"hosts": schema.SetNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Name of the host, unique within the inventory",
+						},
+						"description": schema.StringAttribute{
+							Optional:    true,
+							Description: "Description for the host",
+						},
+						"enabled": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(true),
+							Description: "Denotes if the host is online and is available",
+						},
+						"variables": schema.StringAttribute{
+							Optional:    true,
+							CustomType:  customtypes.AAPCustomVariablesType{},
+							Description: "Variables for the host configuration. Must be provided as either a JSON or YAML string.",
+						},
+					},
+				},
+				Description: "The hosts of the inventory managed by this resource",
+			},
+			"host_ids": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Computed:    true,
+				Description: "IDs of the managed hosts, keyed by host name",
+			},
+		},
+		MarkdownDescription: "Manages a set of hosts within an inventory.\n\n" +
+			"Hosts are created and deleted with the bulk host create and delete endpoints, in batches of " +
+			fmt.Sprintf("%d and %d hosts respectively, ", bulkHostCreateBatchSize, bulkHostDeleteBatchSize) +
+			"and only the hosts whose description, enabled status or variables change are updated individually. " +
+			"This makes the resource suitable for inventories with a large number of hosts. " +
+			"Hosts of the inventory not listed in `hosts` are left untouched.",
+	}
+}
+
+// ValidateConfig checks that host names are unique.
+func (r *InventoryHostsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data InventoryHostsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Hosts.IsNull() || data.Hosts.IsUnknown() {
+		return
+	}
+
+	var hosts []InventoryHostModel
+	resp.Diagnostics.Append(data.Hosts.ElementsAs(ctx, &hosts, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := make(map[string]bool, len(hosts))
+	for _, host := range hosts {
+		if host.Name.IsUnknown() || host.Name.IsNull() {
+			continue
+		}
+		name := host.Name.ValueString()
+		if names[name] {
+			resp.Diagnostics.AddAttributeError(
+				tfpath.Root("hosts"),
+				"Duplicate host name",
+				fmt.Sprintf("The host name %q is declared more than once, host names must be unique within an inventory.", name),
+			)
+		}
+		names[name] = true
+	}
+}
+
+// Create creates the inventory hosts resource and sets the Terraform state on success.
+func (r *InventoryHostsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data InventoryHostsResourceModel
+
+	// Read Terraform plan data into inventory hosts resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var hosts []InventoryHostModel
+	resp.Diagnostics.Append(data.Hosts.ElementsAs(ctx, &hosts, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the hosts in AAP, keeping track of the hosts created before any failure
+	hostIDs := map[string]int64{}
+	applied := map[string]InventoryHostModel{}
+	created, diags := BulkCreateHosts(ctx, r.client, data.InventoryID.ValueInt64(), hosts)
+	resp.Diagnostics.Append(diags...)
+	for _, host := range hosts {
+		if id, ok := created[host.Name.ValueString()]; ok {
+			hostIDs[host.Name.ValueString()] = id
+			applied[host.Name.ValueString()] = host
+		}
+	}
+
+	// Save the state even on failure, so that the created hosts are not orphaned
+	resp.Diagnostics.Append(data.setHosts(ctx, applied, hostIDs)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// Read refreshes the Terraform state with the latest data of the managed hosts.
+func (r *InventoryHostsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InventoryHostsResourceModel
+
+	// Read current Terraform state data into inventory hosts resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, hostIDs, diags := data.getHosts(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := make([]int64, 0, len(hostIDs))
+	for _, id := range hostIDs {
+		ids = append(ids, id)
+	}
+
+	// Get latest host data from AAP, hosts deleted outside of Terraform are dropped from the state
+	apiHosts, diags := GetHostsByID(ctx, r.client, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostIDs = make(map[string]int64, len(apiHosts))
+	applied := make(map[string]InventoryHostModel, len(apiHosts))
+	for _, apiHost := range apiHosts {
+		hostIDs[apiHost.Name] = apiHost.ID
+		applied[apiHost.Name] = InventoryHostModel{
+			Name:        types.StringValue(apiHost.Name),
+			Description: ParseStringValue(apiHost.Description),
+			Enabled:     types.BoolValue(apiHost.Enabled),
+			Variables:   ParseAAPCustomVariablesValue(apiHost.Variables),
+		}
+		// Keep the configured representation of variables when it is semantically equal
+		if prior, ok := state[apiHost.Name]; ok {
+			host := applied[apiHost.Name]
+			if equal, _ := prior.Variables.StringSemanticEquals(ctx, host.Variables); equal {
+				host.Variables = prior.Variables
+				applied[apiHost.Name] = host
+			}
+		}
+	}
+
+	// Save updated state
+	resp.Diagnostics.Append(data.setHosts(ctx, applied, hostIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// Update reconciles the managed hosts with the plan and sets the updated Terraform state.
+func (r *InventoryHostsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state InventoryHostsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planned []InventoryHostModel
+	resp.Diagnostics.Append(data.Hosts.ElementsAs(ctx, &planned, false)...)
+	applied, hostIDs, diags := state.getHosts(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plannedNames := make(map[string]bool, len(planned))
+	for _, host := range planned {
+		plannedNames[host.Name.ValueString()] = true
+	}
+
+	// Save the hosts reconciled so far, even on failure
+	defer func() {
+		resp.Diagnostics.Append(data.setHosts(ctx, applied, hostIDs)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	}()
+
+	// Delete the hosts removed from the plan
+	var removed []int64
+	var removedNames []string
+	for name, id := range hostIDs {
+		if !plannedNames[name] {
+			removed = append(removed, id)
+			removedNames = append(removedNames, name)
+		}
+	}
+	resp.Diagnostics.Append(BulkDeleteHosts(ctx, r.client, removed)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, name := range removedNames {
+		delete(hostIDs, name)
+		delete(applied, name)
+	}
+
+	// Create the hosts added to the plan
+	var added []InventoryHostModel
+	for _, host := range planned {
+		if _, ok := hostIDs[host.Name.ValueString()]; !ok {
+			added = append(added, host)
+		}
+	}
+	created, diags := BulkCreateHosts(ctx, r.client, data.InventoryID.ValueInt64(), added)
+	resp.Diagnostics.Append(diags...)
+	for _, host := range added {
+		if id, ok := created[host.Name.ValueString()]; ok {
+			hostIDs[host.Name.ValueString()] = id
+			applied[host.Name.ValueString()] = host
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the hosts that changed
+	for _, host := range planned {
+		name := host.Name.ValueString()
+		prior, ok := applied[name]
+		if !ok {
+			continue
+		}
+		// Hosts that only differ in representation are saved as planned without an update
+		if prior.equal(ctx, host) {
+			applied[name] = host
+			continue
+		}
+
+		hostURL := path.Join(r.client.getAPIEndpoint(), "hosts", strconv.FormatInt(hostIDs[name], 10))
+		resp.Diagnostics.Append(UpdateHost(r.client, hostURL, data.InventoryID.ValueInt64(), host)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		applied[name] = host
+	}
+}
+
+// Delete deletes all the hosts managed by the inventory hosts resource.
+func (r *InventoryHostsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data InventoryHostsResourceModel
+
+	// Read current Terraform state data into inventory hosts resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var hostIDs map[string]int64
+	resp.Diagnostics.Append(data.HostIDs.ElementsAs(ctx, &hostIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := make([]int64, 0, len(hostIDs))
+	for _, id := range hostIDs {
+		ids = append(ids, id)
+	}
+	resp.Diagnostics.Append(BulkDeleteHosts(ctx, r.client, ids)...)
+}
+
+// BulkCreateHosts creates the given hosts in the inventory in batches, and returns the IDs of the created hosts
+// keyed by name. The hosts created before a failing batch are returned along with the error.
+func BulkCreateHosts(
+	ctx context.Context,
+	client ProviderHTTPClient,
+	inventoryID int64,
+	hosts []InventoryHostModel,
+) (map[string]int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	created := make(map[string]int64, len(hosts))
+
+	createURL := path.Join(client.getAPIEndpoint(), "bulk", "host_create")
+	for start := 0; start < len(hosts); start += bulkHostCreateBatchSize {
+		if !IsContextActive(ctx, "BulkCreateHosts", &diags) {
+			return created, diags
+		}
+
+		batch := hosts[start:min(start+bulkHostCreateBatchSize, len(hosts))]
+		request := BulkHostCreateRequestModel{
+			Inventory: inventoryID,
+			Hosts:     make([]BulkHostAPIModel, 0, len(batch)),
+		}
+		for _, host := range batch {
+			request.Hosts = append(request.Hosts, host.toBulkHostAPIModel())
+		}
+
+		requestBody, err := json.Marshal(request)
+		if err != nil {
+			diags.AddError(
+				"Error marshaling request body",
+				fmt.Sprintf("Could not generate bulk host create request body, unexpected error: %s", err.Error()),
+			)
+			return created, diags
+		}
+
+		body, createDiags := client.Create(createURL, bytes.NewReader(requestBody))
+		diags.Append(createDiags...)
+		if diags.HasError() {
+			return created, diags
+		}
+
+		var response BulkHostCreateResponseModel
+		err = json.Unmarshal(body, &response)
+		if err != nil {
+			diags.AddError("Error parsing JSON response from AAP", err.Error())
+			return created, diags
+		}
+		for _, host := range response.Hosts {
+			created[host.Name] = host.ID
+		}
+		tflog.Debug(ctx, "bulk created hosts", map[string]any{"inventory_id": inventoryID, "count": len(response.Hosts)})
+	}
+
+	return created, diags
+}
+
+// BulkDeleteHosts deletes the hosts with the given IDs in batches.
+func BulkDeleteHosts(ctx context.Context, client ProviderHTTPClient, ids []int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	deleteURL := path.Join(client.getAPIEndpoint(), "bulk", "host_delete")
+	for start := 0; start < len(ids); start += bulkHostDeleteBatchSize {
+		if !IsContextActive(ctx, "BulkDeleteHosts", &diags) {
+			return diags
+		}
+
+		request := BulkHostDeleteRequestModel{Hosts: ids[start:min(start+bulkHostDeleteBatchSize, len(ids))]}
+		requestBody, err := json.Marshal(request)
+		if err != nil {
+			diags.AddError(
+				"Error marshaling request body",
+				fmt.Sprintf("Could not generate bulk host delete request body, unexpected error: %s", err.Error()),
+			)
+			return diags
+		}
+
+		resp, body, err := client.doRequest(http.MethodPost, deleteURL, nil, bytes.NewReader(requestBody))
+		diags.Append(ValidateResponse(resp, body, err, []int{http.StatusOK, http.StatusCreated, http.StatusAccepted})...)
+		if diags.HasError() {
+			return diags
+		}
+		tflog.Debug(ctx, "bulk deleted hosts", map[string]any{"count": len(request.Hosts)})
+	}
+
+	return diags
+}
+
+// GetHostsByID returns the hosts with the given IDs, fetched in batches using the id__in filter.
+// Hosts that no longer exist are omitted.
+func GetHostsByID(ctx context.Context, client ProviderHTTPClient, ids []int64) ([]HostListItemAPIModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	hosts := make([]HostListItemAPIModel, 0, len(ids))
+
+	hostsURL := path.Join(client.getAPIEndpoint(), "hosts")
+	for start := 0; start < len(ids); start += hostsPageSize {
+		batch := ids[start:min(start+hostsPageSize, len(ids))]
+		idFilter := make([]string, 0, len(batch))
+		for _, id := range batch {
+			idFilter = append(idFilter, strconv.FormatInt(id, 10))
+		}
+		params := map[string]string{
+			"id__in":    strings.Join(idFilter, ","),
+			"order_by":  "id",
+			"page_size": strconv.Itoa(hostsPageSize),
+		}
+
+		for page := 1; ; page++ {
+			if !IsContextActive(ctx, "GetHostsByID", &diags) {
+				return nil, diags
+			}
+
+			params["page"] = strconv.Itoa(page)
+			body, pageDiags := client.GetWithParams(hostsURL, params)
+			diags.Append(pageDiags...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			var hostsPage HostListAPIModel
+			err := json.Unmarshal(body, &hostsPage)
+			if err != nil {
+				diags.AddError("Error parsing JSON response from AAP", err.Error())
+				return nil, diags
+			}
+			hosts = append(hosts, hostsPage.Results...)
+
+			if hostsPage.Next == "" {
+				break
+			}
+		}
+	}
+
+	return hosts, diags
+}
+
+// UpdateHost updates the host at the given URL with the data of an inventory hosts resource host.
+func UpdateHost(client ProviderHTTPClient, hostURL string, inventoryID int64, host InventoryHostModel) diag.Diagnostics {
+	bulkHost := host.toBulkHostAPIModel()
+	requestBody, err := json.Marshal(HostAPIModel{
+		InventoryID: inventoryID,
+		Name:        bulkHost.Name,
+		Description: bulkHost.Description,
+		Variables:   bulkHost.Variables,
+		Enabled:     bulkHost.Enabled,
+	})
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not generate request body for host %s, unexpected error: %s", bulkHost.Name, err.Error()),
+		)
+		return diags
+	}
+
+	_, diags := client.Update(hostURL, bytes.NewReader(requestBody))
+	return diags
+}
+
+// toBulkHostAPIModel converts an inventory hosts resource host to the API data model.
+func (h InventoryHostModel) toBulkHostAPIModel() BulkHostAPIModel {
+	return BulkHostAPIModel{
+		Name:        h.Name.ValueString(),
+		Description: h.Description.ValueString(),
+		Enabled:     h.Enabled.IsNull() || h.Enabled.IsUnknown() || h.Enabled.ValueBool(),
+		Variables:   h.Variables.ValueString(),
+	}
+}
+
+// equal reports whether two inventory hosts resource hosts have the same AAP representation.
+func (h InventoryHostModel) equal(ctx context.Context, other InventoryHostModel) bool {
+	if h.toBulkHostAPIModel() == other.toBulkHostAPIModel() {
+		return true
+	}
+	variablesEqual, _ := h.Variables.StringSemanticEquals(ctx, other.Variables)
+	h.Variables, other.Variables = customtypes.NewAAPCustomVariablesNull(), customtypes.NewAAPCustomVariablesNull()
+	return variablesEqual && h.toBulkHostAPIModel() == other.toBulkHostAPIModel()
+}
+
+// getHosts returns the hosts and host IDs of the inventory hosts resource, keyed by host name.
+func (r *InventoryHostsResourceModel) getHosts(ctx context.Context) (map[string]InventoryHostModel, map[string]int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var hosts []InventoryHostModel
+	hostIDs := map[string]int64{}
+
+	diags.Append(r.Hosts.ElementsAs(ctx, &hosts, false)...)
+	if !r.HostIDs.IsNull() && !r.HostIDs.IsUnknown() {
+		diags.Append(r.HostIDs.ElementsAs(ctx, &hostIDs, false)...)
+	}
+
+	byName := make(map[string]InventoryHostModel, len(hosts))
+	for _, host := range hosts {
+		byName[host.Name.ValueString()] = host
+	}
+	return byName, hostIDs, diags
+}
+
+// setHosts sets the hosts and host IDs of the inventory hosts resource, ordering the hosts by name.
+func (r *InventoryHostsResourceModel) setHosts(ctx context.Context, hosts map[string]InventoryHostModel, hostIDs map[string]int64) diag.Diagnostics {
+	var diags, valueDiags diag.Diagnostics
+
+	list := make([]InventoryHostModel, 0, len(hosts))
+	for _, host := range hosts {
+		list = append(list, host)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name.ValueString() < list[j].Name.ValueString() })
+
+	r.Hosts, valueDiags = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: inventoryHostAttrTypes}, list)
+	diags.Append(valueDiags...)
+	r.HostIDs, valueDiags = types.MapValueFrom(ctx, types.Int64Type, hostIDs)
+	diags.Append(valueDiags...)
+	return diags
+}
@@ -0,0 +1,473 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"go.uber.org/mock/gomock"
+)
+
+const resourceNameInventoryHosts = "aap_inventory_hosts.test"
+
+func TestInventoryHostsResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	schemaResponse := &fwresource.SchemaResponse{}
+	NewInventoryHostsResource().Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestInventoryHostsResourceMetadata(t *testing.T) {
+	t.Parallel()
+
+	metadataResponse := fwresource.MetadataResponse{}
+	NewInventoryHostsResource().Metadata(t.Context(), fwresource.MetadataRequest{ProviderTypeName: "aap"}, &metadataResponse)
+
+	expected := "aap_inventory_hosts"
+	if metadataResponse.TypeName != expected {
+		t.Errorf("Expected metadata TypeName %q, received %q", expected, metadataResponse.TypeName)
+	}
+}
+
+// inventoryHost returns a host of the inventory hosts resource with the given name and default values.
+func inventoryHost(name string) InventoryHostModel {
+	return InventoryHostModel{
+		Name:        types.StringValue(name),
+		Description: types.StringNull(),
+		Enabled:     types.BoolValue(true),
+		Variables:   customtypes.NewAAPCustomVariablesNull(),
+	}
+}
+
+// inventoryHosts returns count hosts of the inventory hosts resource named host-<index>.
+func inventoryHosts(count int) []InventoryHostModel {
+	hosts := make([]InventoryHostModel, 0, count)
+	for i := range count {
+		hosts = append(hosts, inventoryHost(fmt.Sprintf("host-%d", i)))
+	}
+	return hosts
+}
+
+func TestInventoryHostsResourceValidateConfig(t *testing.T) {
+	var testTable = []struct {
+		name        string
+		hosts       []InventoryHostModel
+		expectError bool
+	}{
+		{name: "unique names", hosts: inventoryHosts(3), expectError: false},
+		{name: "duplicate names", hosts: append(inventoryHosts(2), inventoryHost("host-1")), expectError: true},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := t.Context()
+			r := NewInventoryHostsResource().(*InventoryHostsResource)
+			schemaResponse := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+
+			// A set does not hold duplicate elements, so the hosts differ by description
+			var elements []InventoryHostModel
+			for i, host := range test.hosts {
+				host.Description = types.StringValue(strconv.Itoa(i))
+				elements = append(elements, host)
+			}
+			hosts, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: inventoryHostAttrTypes}, elements)
+			data := InventoryHostsResourceModel{
+				InventoryID: types.Int64Value(1),
+				Hosts:       hosts,
+				HostIDs:     types.MapNull(types.Int64Type),
+			}
+			plan := tfsdk.Plan{Schema: schemaResponse.Schema}
+			diags.Append(plan.Set(ctx, data)...)
+			if diags.HasError() {
+				t.Fatalf("Unexpected error building the configuration: %v", diags.Errors())
+			}
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: tfsdk.Config{Raw: plan.Raw, Schema: plan.Schema}}, resp)
+			if test.expectError != resp.Diagnostics.HasError() {
+				t.Errorf("Expected error (%t), got (%v)", test.expectError, resp.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestBulkCreateHosts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockProviderHTTPClient(ctrl)
+	mockClient.EXPECT().getAPIEndpoint().Return("/api/v2").AnyTimes()
+
+	hosts := inventoryHosts(bulkHostCreateBatchSize + 20)
+	hosts[0].Variables = customtypes.NewAAPCustomVariablesValue("foo: bar")
+	var batchSizes []int
+	mockClient.EXPECT().Create("/api/v2/bulk/host_create", gomock.Any()).DoAndReturn(
+		func(_ string, data io.Reader) ([]byte, diag.Diagnostics) {
+			var request BulkHostCreateRequestModel
+			if err := json.NewDecoder(data).Decode(&request); err != nil {
+				t.Fatalf("Unexpected request body: %v", err)
+			}
+			if request.Inventory != 5 {
+				t.Errorf("Expected inventory 5, got %d", request.Inventory)
+			}
+			batchSizes = append(batchSizes, len(request.Hosts))
+
+			var response BulkHostCreateResponseModel
+			for _, host := range request.Hosts {
+				id, _ := strconv.ParseInt(strings.TrimPrefix(host.Name, "host-"), 10, 64)
+				response.Hosts = append(response.Hosts, HostListItemAPIModel{ID: id + 100, Name: host.Name})
+			}
+			body, _ := json.Marshal(response)
+			return body, nil
+		},
+	).Times(2)
+
+	created, diags := BulkCreateHosts(t.Context(), mockClient, 5, hosts)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags.Errors())
+	}
+	if !reflect.DeepEqual(batchSizes, []int{bulkHostCreateBatchSize, 20}) {
+		t.Errorf("Expected batches of %d and 20 hosts, got %v", bulkHostCreateBatchSize, batchSizes)
+	}
+	if len(created) != len(hosts) || created["host-0"] != 100 || created["host-119"] != 219 {
+		t.Errorf("Unexpected created hosts: %v", created)
+	}
+}
+
+func TestBulkCreateHostsPartialFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockProviderHTTPClient(ctrl)
+	mockClient.EXPECT().getAPIEndpoint().Return("/api/v2").AnyTimes()
+
+	var failure diag.Diagnostics
+	failure.AddError("Unexpected status code", "400 Bad Request")
+	gomock.InOrder(
+		mockClient.EXPECT().Create("/api/v2/bulk/host_create", gomock.Any()).Return([]byte(`{"hosts": [{"id": 1, "name": "host-0"}]}`), nil),
+		mockClient.EXPECT().Create("/api/v2/bulk/host_create", gomock.Any()).Return(nil, failure),
+	)
+
+	created, diags := BulkCreateHosts(t.Context(), mockClient, 5, inventoryHosts(bulkHostCreateBatchSize+1))
+	if !diags.HasError() {
+		t.Fatal("Expected an error")
+	}
+	if !reflect.DeepEqual(created, map[string]int64{"host-0": 1}) {
+		t.Errorf("Expected the hosts created before the failure, got %v", created)
+	}
+}
+
+func TestBulkDeleteHosts(t *testing.T) {
+	var testTable = []struct {
+		name          string
+		count         int
+		expectedSizes []int
+	}{
+		{name: "no hosts", count: 0, expectedSizes: nil},
+		{name: "single batch", count: 10, expectedSizes: []int{10}},
+		{name: "several batches", count: bulkHostDeleteBatchSize*2 + 1, expectedSizes: []int{bulkHostDeleteBatchSize, bulkHostDeleteBatchSize, 1}},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockProviderHTTPClient(ctrl)
+			mockClient.EXPECT().getAPIEndpoint().Return("/api/v2").AnyTimes()
+
+			ids := make([]int64, 0, test.count)
+			for i := range test.count {
+				ids = append(ids, int64(i+1))
+			}
+			var batchSizes []int
+			mockClient.EXPECT().doRequest(http.MethodPost, "/api/v2/bulk/host_delete", nil, gomock.Any()).DoAndReturn(
+				func(_ string, _ string, _ map[string]string, data io.Reader) (*http.Response, []byte, error) {
+					var request BulkHostDeleteRequestModel
+					if err := json.NewDecoder(data).Decode(&request); err != nil {
+						t.Fatalf("Unexpected request body: %v", err)
+					}
+					batchSizes = append(batchSizes, len(request.Hosts))
+					return &http.Response{StatusCode: http.StatusCreated}, []byte(`{}`), nil
+				},
+			).Times(len(test.expectedSizes))
+
+			diags := BulkDeleteHosts(t.Context(), mockClient, ids)
+			if diags.HasError() {
+				t.Fatalf("Unexpected error: %v", diags.Errors())
+			}
+			if !reflect.DeepEqual(batchSizes, test.expectedSizes) {
+				t.Errorf("Expected batches %v, got %v", test.expectedSizes, batchSizes)
+			}
+		})
+	}
+}
+
+func TestGetHostsByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockProviderHTTPClient(ctrl)
+	mockClient.EXPECT().getAPIEndpoint().Return("/api/v2").AnyTimes()
+
+	ids := make([]int64, 0, hostsPageSize+1)
+	for i := range hostsPageSize + 1 {
+		ids = append(ids, int64(i+1))
+	}
+	var filters []string
+	mockClient.EXPECT().GetWithParams("/api/v2/hosts", gomock.Any()).DoAndReturn(
+		func(_ string, params map[string]string) ([]byte, diag.Diagnostics) {
+			filters = append(filters, params["id__in"]+"@"+params["page"])
+			// The second batch refers to a deleted host, the first batch spans two pages
+			switch {
+			case params["id__in"] == strconv.Itoa(hostsPageSize+1):
+				return []byte(`{"count": 0, "results": []}`), nil
+			case params["page"] == "1":
+				return []byte(`{"count": 2, "next": "/api/v2/hosts/?page=2", "results": [{"id": 1, "name": "host-1"}]}`), nil
+			default:
+				return []byte(`{"count": 2, "results": [{"id": 2, "name": "host-2"}]}`), nil
+			}
+		},
+	).Times(3)
+
+	hosts, diags := GetHostsByID(t.Context(), mockClient, ids)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags.Errors())
+	}
+	if len(hosts) != 2 || hosts[0].Name != "host-1" || hosts[1].Name != "host-2" {
+		t.Errorf("Unexpected hosts: %+v", hosts)
+	}
+	if len(filters) != 3 || !strings.HasPrefix(filters[0], "1,2,3,") || !strings.HasSuffix(filters[1], "@2") ||
+		filters[2] != strconv.Itoa(hostsPageSize+1)+"@1" {
+		t.Errorf("Unexpected requests: %v", filters)
+	}
+}
+
+func TestUpdateHost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockProviderHTTPClient(ctrl)
+	host := inventoryHost("host-1")
+	host.Enabled = types.BoolValue(false)
+	host.Variables = customtypes.NewAAPCustomVariablesValue(`{"foo": "bar"}`)
+
+	expected := `{"inventory":5,"name":"host-1","variables":"{\"foo\": \"bar\"}","enabled":false}`
+	mockClient.EXPECT().Update("/api/v2/hosts/3", gomock.Any()).DoAndReturn(
+		func(_ string, data io.Reader) ([]byte, diag.Diagnostics) {
+			body := new(bytes.Buffer)
+			_, _ = body.ReadFrom(data)
+			if body.String() != expected {
+				t.Errorf("Expected request body %s, got %s", expected, body.String())
+			}
+			return []byte(`{}`), nil
+		},
+	)
+
+	diags := UpdateHost(mockClient, "/api/v2/hosts/3", 5, host)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags.Errors())
+	}
+}
+
+func TestInventoryHostModelEqual(t *testing.T) {
+	ctx := t.Context()
+	withVariables := func(variables string) InventoryHostModel {
+		host := inventoryHost("host")
+		host.Variables = customtypes.NewAAPCustomVariablesValue(variables)
+		return host
+	}
+	disabled := inventoryHost("host")
+	disabled.Enabled = types.BoolValue(false)
+	emptyDescription := inventoryHost("host")
+	emptyDescription.Description = types.StringValue("")
+
+	var testTable = []struct {
+		name     string
+		a        InventoryHostModel
+		b        InventoryHostModel
+		expected bool
+	}{
+		{name: "same host", a: inventoryHost("host"), b: inventoryHost("host"), expected: true},
+		{name: "null and empty description", a: inventoryHost("host"), b: emptyDescription, expected: true},
+		{name: "different enabled", a: inventoryHost("host"), b: disabled, expected: false},
+		{name: "trailing whitespace in variables", a: withVariables("foo: bar"), b: withVariables("foo: bar\n"), expected: true},
+		{name: "different variables", a: withVariables("foo: bar"), b: withVariables("foo: baz"), expected: false},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := test.a.equal(ctx, test.b); actual != test.expected {
+				t.Errorf("Expected (%t), got (%t)", test.expected, actual)
+			}
+		})
+	}
+}
+
+// Acceptance tests
+
+func TestAccInventoryHostsResource(t *testing.T) {
+	inventoryName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	var hostURLs []string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Duplicate host names testing
+			{
+				Config:      testAccInventoryHostsResourceDuplicateNames(inventoryName),
+				ExpectError: regexp.MustCompile("Duplicate host name"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccInventoryHostsResource(inventoryName, 150, "Linux"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameInventoryHosts, "hosts.#", "150"),
+					resource.TestCheckResourceAttr(resourceNameInventoryHosts, "host_ids.%", "150"),
+					resource.TestCheckResourceAttrSet(resourceNameInventoryHosts, "host_ids.host-0"),
+					testAccCheckInventoryHostsCount(150),
+					testAccCollectInventoryHostURLs(&hostURLs),
+				),
+			},
+			// Update and Read testing, removing, adding and updating hosts
+			{
+				Config: testAccInventoryHostsResource(inventoryName, 120, "Windows"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameInventoryHosts, "hosts.#", "120"),
+					resource.TestCheckResourceAttr(resourceNameInventoryHosts, "host_ids.%", "120"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceNameInventoryHosts, "hosts.*", map[string]string{
+						"name":      "host-0",
+						"enabled":   "false",
+						"variables": "os: Windows",
+					}),
+					testAccCheckInventoryHostsCount(120),
+				),
+			},
+			// Plan is empty after apply
+			{
+				Config:   testAccInventoryHostsResource(inventoryName, 120, "Windows"),
+				PlanOnly: true,
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			for _, hostURL := range hostURLs {
+				_, err := testGetResource(hostURL)
+				if err == nil {
+					return fmt.Errorf("host (%s) still exists", hostURL)
+				}
+				if !strings.Contains(err.Error(), "404") {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+}
+
+// testAccInventoryHostsResource returns a configuration for an AAP inventory with count hosts whose first host
+// is disabled and has the given os variable.
+func testAccInventoryHostsResource(inventoryName string, count int, os string) string {
+	return fmt.Sprintf(`
+resource "aap_inventory" "test" {
+  name = "%s"
+}
+
+resource "aap_inventory_hosts" "test" {
+  inventory_id = aap_inventory.test.id
+  hosts = concat([{
+    name        = "host-0"
+    description = "First host"
+    enabled     = false
+    variables   = "os: %s"
+  }], [for i in range(1, %d) : {
+    name = "host-${i}"
+  }])
+}`, inventoryName, os, count)
+}
+
+// testAccInventoryHostsResourceDuplicateNames returns a configuration for an AAP inventory with duplicate host names.
+func testAccInventoryHostsResourceDuplicateNames(inventoryName string) string {
+	return fmt.Sprintf(`
+resource "aap_inventory" "test" {
+  name = "%s"
+}
+
+resource "aap_inventory_hosts" "test" {
+  inventory_id = aap_inventory.test.id
+  hosts = [
+    { name = "host-0" },
+    { name = "host-0", description = "Duplicate" },
+  ]
+}`, inventoryName)
+}
+
+// testAccCheckInventoryHostsCount checks that the inventory holds the given number of hosts.
+func testAccCheckInventoryHostsCount(expected int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		inventory, ok := s.RootModule().Resources[resourceNameInventory]
+		if !ok {
+			return fmt.Errorf("inventory (%s) not found in state", resourceNameInventory)
+		}
+
+		body, err := testGetResource(inventory.Primary.Attributes["url"] + "hosts/")
+		if err != nil {
+			return err
+		}
+		var hosts HostListAPIModel
+		if err := json.Unmarshal(body, &hosts); err != nil {
+			return err
+		}
+		if hosts.Count != expected {
+			return fmt.Errorf("expected %d hosts in the inventory, got %d", expected, hosts.Count)
+		}
+		return nil
+	}
+}
+
+// testAccCollectInventoryHostURLs collects the URLs of the hosts managed by the inventory hosts resource.
+func testAccCollectInventoryHostURLs(hostURLs *[]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		inventory := s.RootModule().Resources[resourceNameInventory]
+		inventoryHosts := s.RootModule().Resources[resourceNameInventoryHosts]
+		if inventory == nil || inventoryHosts == nil {
+			return fmt.Errorf("inventory hosts (%s) not found in state", resourceNameInventoryHosts)
+		}
+
+		// The host URLs share the API prefix of the inventory URL
+		apiPrefix := path.Dir(path.Dir(strings.TrimSuffix(inventory.Primary.Attributes["url"], "/")))
+		for key, id := range inventoryHosts.Primary.Attributes {
+			if strings.HasPrefix(key, "host_ids.") && key != "host_ids.%" {
+				*hostURLs = append(*hostURLs, path.Join(apiPrefix, "hosts", id)+"/")
+			}
+		}
+		return nil
+	}
+}
@@ -5,9 +5,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
+	"net/http"
 	"path"
+	"strconv"
+	"time"
 
 	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,19 +20,29 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// inventoryDeletionTimeoutDefault is the default number of seconds to wait for AAP to delete an inventory.
+const inventoryDeletionTimeoutDefault = 600
+
 // InventoryResourceModel maps the inventory resource schema to a Go struct.
 type InventoryResourceModel struct {
-	ID               tftypes.Int64                    `tfsdk:"id"`
-	Organization     tftypes.Int64                    `tfsdk:"organization"`
-	OrganizationName tftypes.String                   `tfsdk:"organization_name"`
-	URL              tftypes.String                   `tfsdk:"url"`
-	NamedURL         tftypes.String                   `tfsdk:"named_url"`
-	Name             tftypes.String                   `tfsdk:"name"`
-	Description      tftypes.String                   `tfsdk:"description"`
-	Variables        customtypes.AAPCustomStringValue `tfsdk:"variables"`
+	ID                        tftypes.Int64                       `tfsdk:"id"`
+	Organization              tftypes.Int64                       `tfsdk:"organization"`
+	OrganizationName          tftypes.String                      `tfsdk:"organization_name"`
+	URL                       tftypes.String                      `tfsdk:"url"`
+	NamedURL                  tftypes.String                      `tfsdk:"named_url"`
+	Name                      tftypes.String                      `tfsdk:"name"`
+	Description               tftypes.String                      `tfsdk:"description"`
+	Variables                 customtypes.AAPCustomVariablesValue `tfsdk:"variables"`
+	VariablesMap              tftypes.Dynamic                     `tfsdk:"variables_map"`
+	VariablesManagedKeys      tftypes.Bool                        `tfsdk:"variables_managed_keys"`
+	SensitiveVariables        tftypes.Map                         `tfsdk:"sensitive_variables"`
+	SensitiveVariablesVersion tftypes.Int64                       `tfsdk:"sensitive_variables_version"`
+	SensitiveVariablesKeys    tftypes.Set                         `tfsdk:"sensitive_variables_keys"`
+	DeletionTimeout           tftypes.Int64                       `tfsdk:"wait_for_deletion_timeout_seconds"`
 }
 
 // InventoryResource is the resource implementation.
@@ -37,8 +52,9 @@ type InventoryResource struct {
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &InventoryResource{}
-	_ resource.ResourceWithConfigure = &InventoryResource{}
+	_ resource.Resource                     = &InventoryResource{}
+	_ resource.ResourceWithConfigure        = &InventoryResource{}
+	_ resource.ResourceWithConfigValidators = &InventoryResource{}
 )
 
 // NewInventoryResource is a helper function to simplify the provider implementation.
@@ -117,15 +133,33 @@ func (r *InventoryResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "Description for the inventory",
 			},
 			"variables": schema.StringAttribute{
-				Description: "Inventory variables. Must be provided as either a JSON or YAML string.",
-				Optional:    true,
-				CustomType:  customtypes.AAPCustomStringType{},
+				Description: "Inventory variables. Must be provided as either a JSON or YAML string. " +
+					"Conflicts with `variables_map`.",
+				Optional:   true,
+				CustomType: customtypes.AAPCustomVariablesType{},
+			},
+			"variables_map":               variablesMapAttribute("inventory"),
+			"variables_managed_keys":      variablesManagedKeysAttribute("inventory"),
+			"sensitive_variables":         sensitiveVariablesAttribute("inventory"),
+			"sensitive_variables_version": sensitiveVariablesVersionAttribute(),
+			"sensitive_variables_keys":    sensitiveVariablesKeysAttribute(),
+			"wait_for_deletion_timeout_seconds": schema.Int64Attribute{
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+				Description: "AAP deletes inventories asynchronously. Sets the maximum amount of seconds Terraform will wait " +
+					"for the inventory, its hosts and its groups to be deleted, such that an inventory with the same name " +
+					fmt.Sprintf("can be created again. Set to `0` to not wait. Default value of `%d`", inventoryDeletionTimeoutDefault),
 			},
 		},
 		Description: `Creates an inventory.`,
 	}
 }
 
+// ConfigValidators returns the configuration validators of the resource.
+func (r *InventoryResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return variablesConfigValidators()
+}
+
 // Create creates the inventory resource and sets the Terraform state on success.
 func (r *InventoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data InventoryResourceModel
@@ -138,6 +172,16 @@ func (r *InventoryResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	// WriteOnly attributes (sensitive_variables) must be read from the config,
+	// not the plan, because WriteOnly values are always null in the plan.
+	var configData InventoryResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.SensitiveVariables = configData.SensitiveVariables
+	data.SensitiveVariablesKeys = SensitiveVariablesKeys(data.SensitiveVariables)
+
 	// Generate request body from inventory data
 	createRequestBody, diags := data.generateRequestBody()
 	resp.Diagnostics.Append(diags...)
@@ -215,8 +259,35 @@ func (r *InventoryResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	// WriteOnly attributes (sensitive_variables) must be read from the config,
+	// not the plan, because WriteOnly values are always null in the plan.
+	var configData InventoryResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.SensitiveVariables = configData.SensitiveVariables
+	data.SensitiveVariablesKeys = SensitiveVariablesKeys(data.SensitiveVariables)
+
+	// Only update the managed keys of the variables
+	requestModel := data
+	if data.VariablesManagedKeys.ValueBool() {
+		var state InventoryResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		requestModel.Variables, diags = MergeManagedVariables(r.client, data.URL.ValueString(),
+			state.Variables, state.VariablesMap, state.SensitiveVariablesKeys, data.Variables, data.VariablesMap)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		requestModel.VariablesMap = tftypes.DynamicNull()
+	}
+
 	// Generate request body from inventory data
-	updateRequestBody, diags := data.generateRequestBody()
+	updateRequestBody, diags := requestModel.generateRequestBody()
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -263,10 +334,110 @@ func (r *InventoryResource) Delete(ctx context.Context, req resource.DeleteReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Wait for AAP to complete the deletion
+	timeout := int64(inventoryDeletionTimeoutDefault)
+	if !data.DeletionTimeout.IsNull() {
+		timeout = data.DeletionTimeout.ValueInt64()
+	}
+	if timeout > 0 {
+		resp.Diagnostics.Append(WaitForInventoryDeletion(ctx, r.client, data.URL.ValueString(), data.ID.ValueInt64(), timeout)...)
+	}
+}
+
+// WaitForInventoryDeletion waits until AAP has deleted the inventory at the given URL, and the hosts and groups
+// of the inventory. The timeout, in seconds, applies to the whole wait.
+func WaitForInventoryDeletion(ctx context.Context, client ProviderHTTPClient, inventoryURL string, inventoryID int64,
+	timeout int64) diag.Diagnostics {
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+
+	// The inventory is pending deletion while it can still be retrieved
+	inventoryDeleted := func() ([]byte, diag.Diagnostics, int) {
+		return client.GetWithStatus(inventoryURL, nil)
+	}
+	diags := waitForDeletion(ctx, "inventory deletion", inventoryDeleted, append([]int{http.StatusOK}, DefaultRetryableStatusCodes...),
+		deadline, DefaultRetryInitialDelay)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, related := range []string{"hosts", "groups"} {
+		relatedURL := path.Join(client.getAPIEndpoint(), related)
+		params := map[string]string{"inventory": strconv.FormatInt(inventoryID, 10), "page_size": "1"}
+		relatedDeleted := func() ([]byte, diag.Diagnostics, int) {
+			body, diags, status := client.GetWithStatus(relatedURL, params)
+			if status != http.StatusOK {
+				return body, diags, status
+			}
+
+			var list struct {
+				Count int64 `json:"count"`
+			}
+			err := json.Unmarshal(body, &list)
+			if err != nil {
+				diags.AddError("Error parsing JSON response from AAP", err.Error())
+				return body, diags, http.StatusUnprocessableEntity
+			}
+			if list.Count > 0 {
+				// Still referencing the inventory, retry
+				return body, diags, http.StatusConflict
+			}
+			return body, diags, http.StatusNotFound
+		}
+
+		diags.Append(waitForDeletion(ctx, "inventory "+related+" deletion", relatedDeleted, DefaultRetryableStatusCodes, deadline, 0)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// waitForDeletion retries the given operation until it returns a 404 status code, or the deadline is reached.
+func waitForDeletion(ctx context.Context, operationName string, operation RetryOperationFunc, retryableStatusCodes []int,
+	deadline time.Time, initialDelay int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	timeout := int64(math.Ceil(time.Until(deadline).Seconds()))
+	if timeout <= 0 {
+		diags.AddError(
+			"Error waiting for deletion",
+			fmt.Sprintf("Could not complete the %s: the timeout was reached", operationName),
+		)
+		return diags
+	}
+
+	retryConfig, diags := CreateRetryConfig(ctx, operationName, operation, []int{http.StatusNotFound}, retryableStatusCodes,
+		timeout, initialDelay, DefaultRetryDelay)
+	if diags.HasError() {
+		return diags
+	}
+
+	retryResult, err := RetryWithConfig(retryConfig)
+	if retryResult != nil {
+		diags.Append(retryResult.Diags...)
+	}
+	if err != nil {
+		diags.AddError(
+			"Error waiting for deletion",
+			fmt.Sprintf("Could not complete the %s: %s", operationName, err.Error()),
+		)
+	}
+	return diags
 }
 
 // generateRequestBody creates a JSON encoded request body from the inventory resource data.
 func (r *InventoryResourceModel) generateRequestBody() ([]byte, diag.Diagnostics) {
+	variables, diags := RequestVariables(r.Variables, r.VariablesMap)
+	if diags.HasError() {
+		return nil, diags
+	}
+	variables, diags = MergeSensitiveVariables(variables, r.SensitiveVariables)
+	if diags.HasError() {
+		return nil, diags
+	}
+
 	// Convert inventory resource data to API data model
 	var organizationID int64
 
@@ -296,7 +467,7 @@ func (r *InventoryResourceModel) generateRequestBody() ([]byte, diag.Diagnostics
 				Related: RelatedAPIModel{
 					NamedURL: r.NamedURL.ValueString(),
 				},
-				Variables: r.Variables.ValueString(),
+				Variables: variables,
 			},
 			SummaryFields: SummaryFieldsAPIModel{
 				Organization: SummaryField{
@@ -346,7 +517,12 @@ func (r *InventoryResourceModel) parseHTTPResponse(body []byte) diag.Diagnostics
 	r.NamedURL = ParseStringValue(apiInventory.Related.NamedURL)
 	r.Name = tftypes.StringValue(apiInventory.Name)
 	r.Description = ParseStringValue(apiInventory.Description)
-	r.Variables = ParseAAPCustomStringValue(apiInventory.Variables)
+	variables, parseResponseDiags := RemoveSensitiveVariables(apiInventory.Variables, r.SensitiveVariablesKeys)
+	if parseResponseDiags.HasError() {
+		return parseResponseDiags
+	}
+	r.Variables, r.VariablesMap, parseResponseDiags = ParseVariables(variables, r.Variables, r.VariablesMap,
+		r.VariablesManagedKeys)
 
 	return parseResponseDiags
 }
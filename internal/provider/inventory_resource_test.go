@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -16,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"go.uber.org/mock/gomock"
 )
 
 func TestInventoryResourceSchema(t *testing.T) {
@@ -56,7 +60,7 @@ func TestInventoryResourceGenerateRequestBody(t *testing.T) {
 				NamedURL:         tftypes.StringUnknown(),
 				Name:             tftypes.StringUnknown(),
 				Description:      tftypes.StringUnknown(),
-				Variables:        customtypes.NewAAPCustomStringUnknown(),
+				Variables:        customtypes.NewAAPCustomVariablesUnknown(),
 			},
 			expected: []byte(`{"id":0,"url":"","related":{},"summary_fields":{"organization":{"id":1,"name":""},"inventory":{"id":0,"name":""}},"organization":1}`),
 		},
@@ -70,7 +74,7 @@ func TestInventoryResourceGenerateRequestBody(t *testing.T) {
 				NamedURL:         tftypes.StringNull(),
 				Name:             tftypes.StringNull(),
 				Description:      tftypes.StringNull(),
-				Variables:        customtypes.NewAAPCustomStringNull(),
+				Variables:        customtypes.NewAAPCustomVariablesNull(),
 			},
 			expected: []byte(`{"id":0,"url":"","related":{},"summary_fields":{"organization":{"id":1,"name":""},"inventory":{"id":0,"name":""}},"organization":1}`),
 		},
@@ -83,7 +87,7 @@ func TestInventoryResourceGenerateRequestBody(t *testing.T) {
 				URL:              tftypes.StringValue("/inventories/1/"),
 				Name:             tftypes.StringValue("test inventory"),
 				Description:      tftypes.StringValue("A test inventory for testing"),
-				Variables:        customtypes.NewAAPCustomStringValue("{\"foo\": \"bar\", \"nested\": {\"foobar\": \"baz\"}}"),
+				Variables:        customtypes.NewAAPCustomVariablesValue("{\"foo\": \"bar\", \"nested\": {\"foobar\": \"baz\"}}"),
 			},
 			expected: []byte(
 				`{"id":1,"url":"/inventories/1/","description":"A test inventory for testing","name":"test inventory","related":{"named_url":"inventories/1"},` +
@@ -131,7 +135,7 @@ func TestInventoryResourceParseHTTPResponse(t *testing.T) {
 				URL:          tftypes.StringValue("/inventories/1/"),
 				Name:         tftypes.StringValue("test inventory"),
 				Description:  tftypes.StringNull(),
-				Variables:    customtypes.NewAAPCustomStringNull(),
+				Variables:    customtypes.NewAAPCustomVariablesNull(),
 			},
 			errors: diag.Diagnostics{},
 		},
@@ -147,7 +151,7 @@ func TestInventoryResourceParseHTTPResponse(t *testing.T) {
 				URL:          tftypes.StringValue("/inventories/1/"),
 				Name:         tftypes.StringValue("test inventory"),
 				Description:  tftypes.StringValue("A test inventory for testing"),
-				Variables:    customtypes.NewAAPCustomStringValue("{\"foo\":\"bar\",\"nested\":{\"foobar\":\"baz\"}}"),
+				Variables:    customtypes.NewAAPCustomVariablesValue("{\"foo\":\"bar\",\"nested\":{\"foobar\":\"baz\"}}"),
 			},
 			errors: diag.Diagnostics{},
 		},
@@ -160,13 +164,67 @@ func TestInventoryResourceParseHTTPResponse(t *testing.T) {
 			if !test.errors.Equal(diags) {
 				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
 			}
-			if test.expected != resource {
+			if !reflect.DeepEqual(test.expected, resource) {
 				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, resource)
 			}
 		})
 	}
 }
 
+func TestWaitForInventoryDeletion(t *testing.T) {
+	var testTable = []struct {
+		name            string
+		inventoryStatus int
+		hostsCount      string
+		expectError     bool
+	}{
+		{name: "deleted", inventoryStatus: http.StatusNotFound, hostsCount: "0", expectError: false},
+		{name: "inventory error", inventoryStatus: http.StatusBadRequest, expectError: true},
+		{name: "hosts error", inventoryStatus: http.StatusNotFound, hostsCount: "invalid", expectError: true},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockProviderHTTPClient(ctrl)
+			mockClient.EXPECT().getAPIEndpoint().Return("/api/v2").AnyTimes()
+			mockClient.EXPECT().GetWithStatus("/api/v2/inventories/3/", nil).Return(nil, diag.Diagnostics{}, test.inventoryStatus)
+			params := map[string]string{"inventory": "3", "page_size": "1"}
+			if test.hostsCount != "" {
+				mockClient.EXPECT().GetWithStatus("/api/v2/hosts", params).Return(
+					[]byte(`{"count": `+test.hostsCount+`}`), diag.Diagnostics{}, http.StatusOK,
+				)
+			}
+			if !test.expectError {
+				mockClient.EXPECT().GetWithStatus("/api/v2/groups", params).Return(
+					[]byte(`{"count": 0}`), diag.Diagnostics{}, http.StatusOK,
+				)
+			}
+
+			diags := WaitForInventoryDeletion(t.Context(), mockClient, "/api/v2/inventories/3/", 3, 30)
+			if test.expectError != diags.HasError() {
+				t.Errorf("Expected error (%t), got (%v)", test.expectError, diags.Errors())
+			}
+		})
+	}
+}
+
+func TestWaitForDeletionDeadline(t *testing.T) {
+	operation := func() ([]byte, diag.Diagnostics, int) {
+		t.Error("Expected the operation not to be retried once the deadline is reached")
+		return nil, diag.Diagnostics{}, http.StatusOK
+	}
+
+	diags := waitForDeletion(t.Context(), "test deletion", operation, nil, time.Now().Add(-time.Second), 0)
+	if !diags.HasError() {
+		t.Error("Expected an error once the deadline is reached")
+	}
+}
+
+// Acceptance tests
+
 func TestAccInventoryResource(t *testing.T) {
 	var inventory InventoryAPIModel
 	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
@@ -232,6 +290,54 @@ resource "aap_inventory" "test" {
 }`, name)
 }
 
+func TestAccInventoryResourceReplace(t *testing.T) {
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInventoryResourceReplace(randomName, "first"),
+				Check:  resource.TestCheckResourceAttr(resourceNameInventory, "name", randomName),
+			},
+			// Replace the inventory by one with the same name, which requires the deletion to be complete
+			{
+				Config: testAccInventoryResourceReplace(randomName, "second"),
+				Check:  resource.TestCheckResourceAttr(resourceNameInventory, "name", randomName),
+			},
+		},
+		CheckDestroy: testAccCheckInventoryResourceDestroy,
+	})
+}
+
+// testAccInventoryResourceReplace returns a configuration for an AAP Inventory with a host and a group,
+// replaced whenever the trigger changes.
+func testAccInventoryResourceReplace(name string, trigger string) string {
+	return fmt.Sprintf(`
+resource "terraform_data" "trigger" {
+  input = "%s"
+}
+
+resource "aap_inventory" "test" {
+  name = "%s"
+
+  lifecycle {
+    replace_triggered_by = [terraform_data.trigger]
+  }
+}
+
+resource "aap_host" "test" {
+  name = "host-%s"
+  inventory_id = aap_inventory.test.id
+}
+
+resource "aap_group" "test" {
+  name = "group-%s"
+  inventory_id = aap_inventory.test.id
+}`, trigger, name, name, name)
+}
+
 // testAccInventoryResourceBadVariables returns a configuration for an AAP Inventory with the provided name and invalid variables.
 func testAccInventoryResourceBadVariables(name string) string {
 	return fmt.Sprintf(`
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// InventorySourceUpdateAction represents an action syncing an inventory source in AAP.
+type InventorySourceUpdateAction struct {
+	client ProviderHTTPClient
+}
+
+func NewInventorySourceUpdateAction() action.Action {
+	return &InventorySourceUpdateAction{}
+}
+
+var (
+	_ action.Action                     = (*InventorySourceUpdateAction)(nil)
+	_ action.ActionWithConfigValidators = (*InventorySourceUpdateAction)(nil)
+)
+
+type InventorySourceUpdateActionModel struct {
+	InventorySourceID        types.Int64  `tfsdk:"inventory_source_id"`
+	InventorySourceName      types.String `tfsdk:"inventory_source_name"`
+	InventoryName            types.String `tfsdk:"inventory_name"`
+	OrganizationName         types.String `tfsdk:"organization_name"`
+	WaitForCompletion        types.Bool   `tfsdk:"wait_for_completion"`
+	WaitForCompletionTimeout types.Int64  `tfsdk:"wait_for_completion_timeout_seconds"`
+}
+
+// Schema defines the schema for the inventory source update action
+func (a *InventorySourceUpdateAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"inventory_source_id": schema.Int64Attribute{
+				Optional: true,
+				Description: "ID of the inventory source. Required unless `inventory_source_name`, `inventory_name` " +
+					"and `organization_name` are provided.",
+			},
+			"inventory_source_name": schema.StringAttribute{
+				Optional: true,
+				Description: "Name of the inventory source, to reference it together with `inventory_name` and " +
+					"`organization_name` instead of `inventory_source_id`.",
+			},
+			"inventory_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the inventory of the inventory source referenced by `inventory_source_name`.",
+			},
+			"organization_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the organization of the inventory referenced by `inventory_name`.",
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+				Description: "When this is set to `true`, Terraform will wait until the inventory update reaches " +
+					"any final status and then, proceeds with the following resource operation",
+			},
+			"wait_for_completion_timeout_seconds": schema.Int64Attribute{
+				Optional: true,
+				Description: "Sets the maximum amount of seconds Terraform will wait before timing out the update, " +
+					"and the action will fail. Default value of `120`",
+			},
+		},
+		MarkdownDescription: "Syncs an AAP inventory source.\n\n" +
+			"This action launches an inventory update in AAP, refreshing a dynamic inventory from its source. \n" +
+			"Moreover, you can set `wait_for_completion` to true, then Terraform will " +
+			"wait until the update reaches any final state before continuing. An update that does not succeed " +
+			"fails the action with the last lines of its standard output. " +
+			"You can also tweak `wait_for_completion_timeout_seconds` to control the timeout limit.",
+	}
+}
+
+// ConfigValidators returns the configuration validators of the inventory source update action.
+func (a *InventorySourceUpdateAction) ConfigValidators(_ context.Context) []action.ConfigValidator {
+	return namedActionConfigValidators("inventory_source_id", "inventory_source_name", "inventory_name", "organization_name")
+}
+
+// InventorySourceURL returns the URL of the inventory source, referenced by ID or by its named URL.
+func (r *InventorySourceUpdateActionModel) InventorySourceURL(client ProviderHTTPClient) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	inventorySourcesURI := path.Join(client.getAPIEndpoint(), "inventory_sources")
+
+	inventorySourceID := r.InventorySourceID.ValueInt64()
+	if r.InventorySourceID.IsNull() {
+		// The named URL of an inventory source is made of its name and the named URL of its inventory.
+		namedURL := path.Join(inventorySourcesURI, fmt.Sprintf("%s++%s++%s",
+			r.InventorySourceName.ValueString(), r.InventoryName.ValueString(), r.OrganizationName.ValueString()))
+		inventorySourceID, diags = ResolveNamedURLID(client, namedURL)
+		if diags.HasError() {
+			return "", diags
+		}
+	}
+
+	return path.Join(inventorySourcesURI, strconv.FormatInt(inventorySourceID, 10)), diags
+}
+
+// Invoke executes the inventory source update action.
+func (a *InventorySourceUpdateAction) Invoke(ctx context.Context, req action.InvokeRequest, response *action.InvokeResponse) {
+	var config InventorySourceUpdateActionModel
+
+	response.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set default timeout if not provided
+	if config.WaitForCompletionTimeout.IsNull() {
+		config.WaitForCompletionTimeout = types.Int64Value(waitForCompletionTimeoutDefault)
+	}
+
+	inventorySourceURL, diags := config.InventorySourceURL(a.client)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	options := SourceUpdateOptions{
+		Kind:              "inventory update",
+		WaitForCompletion: config.WaitForCompletion.ValueBool(),
+		Timeout:           time.Duration(config.WaitForCompletionTimeout.ValueInt64()) * time.Second,
+	}
+	progress := func(message string) {
+		response.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+	response.Diagnostics.Append(RunSourceUpdate(ctx, a.client, inventorySourceURL, options, progress)...)
+}
+
+// Configure configures the inventory source update action with the provider client
+func (a *InventorySourceUpdateAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+// Metadata returns the action metadata
+func (a *InventorySourceUpdateAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inventory_source_update"
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	fwaction "github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.uber.org/mock/gomock"
+)
+
+// TestInventorySourceUpdateActionSchema tests the Schema function
+func TestInventorySourceUpdateActionSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	schemaResponse := fwaction.SchemaResponse{}
+	NewInventorySourceUpdateAction().Schema(ctx, fwaction.SchemaRequest{}, &schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+// TestInventorySourceUpdateActionMetadata tests the Metadata function
+func TestInventorySourceUpdateActionMetadata(t *testing.T) {
+	t.Parallel()
+
+	metadataResponse := fwaction.MetadataResponse{}
+	NewInventorySourceUpdateAction().Metadata(t.Context(), fwaction.MetadataRequest{ProviderTypeName: "aap"}, &metadataResponse)
+
+	expected := "aap_inventory_source_update"
+	if metadataResponse.TypeName != expected {
+		t.Errorf("Expected metadata TypeName %q, received %q", expected, metadataResponse.TypeName)
+	}
+}
+
+func TestInventorySourceUpdateActionInventorySourceURL(t *testing.T) {
+	var testTable = []struct {
+		name      string
+		model     InventorySourceUpdateActionModel
+		setupMock func(*MockProviderHTTPClient)
+	}{
+		{
+			name:      "inventory source id",
+			model:     InventorySourceUpdateActionModel{InventorySourceID: types.Int64Value(3)},
+			setupMock: func(_ *MockProviderHTTPClient) {},
+		},
+		{
+			name: "inventory source name",
+			model: InventorySourceUpdateActionModel{
+				InventorySourceID:   types.Int64Null(),
+				InventorySourceName: types.StringValue("AWS"),
+				InventoryName:       types.StringValue("Cloud"),
+				OrganizationName:    types.StringValue("Default"),
+			},
+			setupMock: func(mock *MockProviderHTTPClient) {
+				mock.EXPECT().Get("/api/v2/inventory_sources/AWS++Cloud++Default").Return([]byte(`{"id": 3}`), diag.Diagnostics{})
+			},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockProviderHTTPClient(ctrl)
+			mockClient.EXPECT().getAPIEndpoint().Return("/api/v2")
+			test.setupMock(mockClient)
+
+			inventorySourceURL, diags := test.model.InventorySourceURL(mockClient)
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			if inventorySourceURL != "/api/v2/inventory_sources/3" {
+				t.Errorf("Expected inventory source URL /api/v2/inventory_sources/3 but got %s", inventorySourceURL)
+			}
+		})
+	}
+}
+
+func TestAccAAPInventorySourceUpdateAction_basic(t *testing.T) {
+	inventorySourceID := os.Getenv("AAP_TEST_INVENTORY_SOURCE_ID")
+	if inventorySourceID == "" {
+		t.Skip("AAP_TEST_INVENTORY_SOURCE_ID environment variable not set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInventorySourceUpdateAction(inventorySourceID),
+			},
+		},
+	})
+}
+
+func testAccInventorySourceUpdateAction(inventorySourceID string) string {
+	return fmt.Sprintf(`
+resource "terraform_data" "trigger" {
+	input = "inventory-source-update"
+	lifecycle {
+		action_trigger {
+			events  = [after_create]
+			actions = [action.aap_inventory_source_update.test]
+		}
+	}
+}
+
+action "aap_inventory_source_update" "test" {
+	config {
+		inventory_source_id = %s
+		wait_for_completion = true
+	}
+}
+`, inventorySourceID)
+}
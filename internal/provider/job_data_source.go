@@ -0,0 +1,474 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// jobHostSummariesPageSize is the page size used to retrieve the job host summaries.
+const jobHostSummariesPageSize = 200
+
+// jobStdoutTooLargeNotice starts the notice returned by AAP instead of the standard output of a job
+// exceeding the display limit of the txt format.
+const jobStdoutTooLargeNotice = "Standard Output too large to display"
+
+// JobDetailsAPIModel represents the AAP API model of a job including its results.
+// /api/controller/v2/jobs/<id>/
+type JobDetailsAPIModel struct {
+	ID             int64           `json:"id"`
+	URL            string          `json:"url"`
+	Name           string          `json:"name"`
+	Status         string          `json:"status"`
+	Failed         bool            `json:"failed"`
+	JobTemplate    int64           `json:"job_template"`
+	Inventory      int64           `json:"inventory"`
+	LaunchType     string          `json:"launch_type"`
+	JobExplanation string          `json:"job_explanation"`
+	Started        string          `json:"started"`
+	Finished       string          `json:"finished"`
+	Elapsed        float64         `json:"elapsed"`
+	Artifacts      json.RawMessage `json:"artifacts"`
+}
+
+// JobHostSummaryAPIModel represents a host summary of a job.
+// /api/controller/v2/jobs/<id>/job_host_summaries/
+type JobHostSummaryAPIModel struct {
+	HostName  string `json:"host_name"`
+	Ok        int64  `json:"ok"`
+	Changed   int64  `json:"changed"`
+	Failures  int64  `json:"failures"`
+	Dark      int64  `json:"dark"`
+	Skipped   int64  `json:"skipped"`
+	Rescued   int64  `json:"rescued"`
+	Ignored   int64  `json:"ignored"`
+	Failed    bool   `json:"failed"`
+	Processed int64  `json:"processed"`
+}
+
+// JobHostSummaryListAPIModel represents a page of the job host summaries.
+type JobHostSummaryListAPIModel struct {
+	Next    string                   `json:"next"`
+	Results []JobHostSummaryAPIModel `json:"results"`
+}
+
+// JobDataSourceModel maps the job data source schema data.
+type JobDataSourceModel struct {
+	ID              tftypes.Int64   `tfsdk:"id"`
+	URL             tftypes.String  `tfsdk:"url"`
+	Name            tftypes.String  `tfsdk:"name"`
+	Status          tftypes.String  `tfsdk:"status"`
+	Failed          tftypes.Bool    `tfsdk:"failed"`
+	JobTemplateID   tftypes.Int64   `tfsdk:"job_template_id"`
+	InventoryID     tftypes.Int64   `tfsdk:"inventory_id"`
+	LaunchType      tftypes.String  `tfsdk:"launch_type"`
+	JobExplanation  tftypes.String  `tfsdk:"job_explanation"`
+	Started         tftypes.String  `tfsdk:"started"`
+	Finished        tftypes.String  `tfsdk:"finished"`
+	Elapsed         tftypes.Float64 `tfsdk:"elapsed"`
+	Artifacts       tftypes.String  `tfsdk:"artifacts"`
+	HostSummaries   tftypes.Map     `tfsdk:"host_summaries"`
+	StdoutLines     tftypes.Int64   `tfsdk:"stdout_lines"`
+	Stdout          tftypes.String  `tfsdk:"stdout"`
+	StdoutTruncated tftypes.Bool    `tfsdk:"stdout_truncated"`
+}
+
+// JobHostSummaryModel maps the host summary of the job data source.
+type JobHostSummaryModel struct {
+	Ok          tftypes.Int64 `tfsdk:"ok"`
+	Changed     tftypes.Int64 `tfsdk:"changed"`
+	Failed      tftypes.Int64 `tfsdk:"failed"`
+	Unreachable tftypes.Int64 `tfsdk:"unreachable"`
+	Skipped     tftypes.Int64 `tfsdk:"skipped"`
+	Rescued     tftypes.Int64 `tfsdk:"rescued"`
+	Ignored     tftypes.Int64 `tfsdk:"ignored"`
+}
+
+// jobHostSummaryAttrTypes are the attribute types of JobHostSummaryModel.
+var jobHostSummaryAttrTypes = map[string]attr.Type{
+	"ok":          tftypes.Int64Type,
+	"changed":     tftypes.Int64Type,
+	"failed":      tftypes.Int64Type,
+	"unreachable": tftypes.Int64Type,
+	"skipped":     tftypes.Int64Type,
+	"rescued":     tftypes.Int64Type,
+	"ignored":     tftypes.Int64Type,
+}
+
+// JobDataSource is the data source implementation.
+type JobDataSource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &JobDataSource{}
+	_ datasource.DataSourceWithConfigure = &JobDataSource{}
+)
+
+// NewJobDataSource is a helper function to simplify the provider implementation.
+func NewJobDataSource() datasource.DataSource {
+	return &JobDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *JobDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job"
+}
+
+// Schema defines the schema fields for the data source.
+func (d *JobDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Job id",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the Job",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the Job",
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+				Description: "Status of the Job. One of `new`, `pending`, `waiting`, `running`, `successful`, " +
+					"`failed`, `error` or `canceled`.",
+			},
+			"failed": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the Job failed",
+			},
+			"job_template_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Identifier for the job template the Job was launched from",
+			},
+			"inventory_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Identifier for the inventory the Job ran against",
+			},
+			"launch_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "How the Job was launched, for example `manual`, `scheduled` or `workflow`.",
+			},
+			"job_explanation": schema.StringAttribute{
+				Computed:    true,
+				Description: "Explanation of the Job status, set by AAP when the Job could not run.",
+			},
+			"started": schema.StringAttribute{
+				Computed:    true,
+				Description: "The date and time the Job was queued for starting.",
+			},
+			"finished": schema.StringAttribute{
+				Computed:    true,
+				Description: "The date and time the Job finished execution.",
+			},
+			"elapsed": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Elapsed time in seconds that the Job ran.",
+			},
+			"artifacts": schema.StringAttribute{
+				Computed: true,
+				Description: "JSON encoded artifacts of the Job, as set by the `set_stats` module. " +
+					"Use `jsondecode` to access the values.",
+			},
+			"host_summaries": schema.MapNestedAttribute{
+				Computed:    true,
+				Description: "Per host task results of the Job, keyed by host name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ok": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of tasks that ran successfully on the host.",
+						},
+						"changed": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of tasks that changed the host.",
+						},
+						"failed": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of tasks that failed on the host.",
+						},
+						"unreachable": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of tasks for which the host was unreachable.",
+						},
+						"skipped": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of tasks skipped on the host.",
+						},
+						"rescued": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of failed tasks rescued on the host.",
+						},
+						"ignored": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of failed tasks ignored on the host.",
+						},
+					},
+				},
+			},
+			"stdout_lines": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "Number of trailing lines of the Job standard output to retrieve into `stdout`. " +
+					"If not provided, the standard output is not retrieved.",
+			},
+			"stdout": schema.StringAttribute{
+				Computed: true,
+				Description: "The last `stdout_lines` lines of the Job standard output. The complete output is downloaded " +
+					"when it exceeds the display limit of AAP (1 MB by default), so large outputs are retrieved in full.",
+			},
+			"stdout_truncated": schema.BoolAttribute{
+				Computed: true,
+				Description: "Whether the Job standard output has more than `stdout_lines` lines, " +
+					"so that `stdout` only holds its last lines.",
+			},
+		},
+		Description: "Get the status and results of an existing Job.",
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *JobDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *JobDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state JobDataSourceModel
+
+	// Check Read preconditions
+	if !DoReadPreconditionsMeet(ctx, resp, d.client) {
+		return
+	}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobURL := path.Join(d.client.getAPIEndpoint(), "jobs", strconv.FormatInt(state.ID.ValueInt64(), 10))
+	body, diags := d.client.Get(jobURL)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(state.ParseHTTPResponse(body)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostSummaries, diags := GetJobHostSummaries(ctx, d.client, jobURL)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(state.ParseHostSummaries(ctx, hostSummaries)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Stdout = tftypes.StringNull()
+	state.StdoutTruncated = tftypes.BoolNull()
+	if IsValueProvided(state.StdoutLines) {
+		stdout, diags := GetJobStdout(d.client, jobURL)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		tail := TailLines(stdout, state.StdoutLines.ValueInt64())
+		state.Stdout = tftypes.StringValue(tail)
+		state.StdoutTruncated = tftypes.BoolValue(tail != strings.TrimRight(stdout, "\n"))
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ParseHTTPResponse updates the job data source model from an AAP API response.
+func (d *JobDataSourceModel) ParseHTTPResponse(body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var job JobDetailsAPIModel
+	err := json.Unmarshal(body, &job)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	artifacts, diags := ParseJobArtifacts(job.Artifacts)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.ID = tftypes.Int64Value(job.ID)
+	d.URL = tftypes.StringValue(job.URL)
+	d.Name = tftypes.StringValue(job.Name)
+	d.Status = tftypes.StringValue(job.Status)
+	d.Failed = tftypes.BoolValue(job.Failed)
+	d.JobTemplateID = tftypes.Int64Value(job.JobTemplate)
+	d.InventoryID = tftypes.Int64Value(job.Inventory)
+	d.LaunchType = ParseStringValue(job.LaunchType)
+	d.JobExplanation = ParseStringValue(job.JobExplanation)
+	d.Started = ParseStringValue(job.Started)
+	d.Finished = ParseStringValue(job.Finished)
+	d.Elapsed = tftypes.Float64Value(job.Elapsed)
+	d.Artifacts = artifacts
+
+	return diags
+}
+
+// ParseHostSummaries updates the job data source model with the host summaries of the job.
+func (d *JobDataSourceModel) ParseHostSummaries(ctx context.Context, hostSummaries []JobHostSummaryAPIModel) diag.Diagnostics {
+	summaries := make(map[string]JobHostSummaryModel, len(hostSummaries))
+	for _, summary := range hostSummaries {
+		summaries[summary.HostName] = JobHostSummaryModel{
+			Ok:          tftypes.Int64Value(summary.Ok),
+			Changed:     tftypes.Int64Value(summary.Changed),
+			Failed:      tftypes.Int64Value(summary.Failures),
+			Unreachable: tftypes.Int64Value(summary.Dark),
+			Skipped:     tftypes.Int64Value(summary.Skipped),
+			Rescued:     tftypes.Int64Value(summary.Rescued),
+			Ignored:     tftypes.Int64Value(summary.Ignored),
+		}
+	}
+
+	var diags diag.Diagnostics
+	d.HostSummaries, diags = tftypes.MapValueFrom(ctx, tftypes.ObjectType{AttrTypes: jobHostSummaryAttrTypes}, summaries)
+	return diags
+}
+
+// ParseJobArtifacts returns the job artifacts as a JSON encoded string. Jobs without
+// artifacts return an empty JSON object.
+func ParseJobArtifacts(artifacts json.RawMessage) (tftypes.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(artifacts) == 0 || string(artifacts) == "null" {
+		return tftypes.StringValue("{}"), diags
+	}
+
+	// Compact the artifacts so the value doesn't depend on the API response formatting
+	var value interface{}
+	err := json.Unmarshal(artifacts, &value)
+	if err != nil {
+		diags.AddError("Error parsing job artifacts from AAP", err.Error())
+		return tftypes.StringNull(), diags
+	}
+	compacted, err := json.Marshal(value)
+	if err != nil {
+		diags.AddError("Error parsing job artifacts from AAP", err.Error())
+		return tftypes.StringNull(), diags
+	}
+	return tftypes.StringValue(string(compacted)), diags
+}
+
+// GetJobHostSummaries retrieves all the host summaries of a job.
+func GetJobHostSummaries(ctx context.Context, client ProviderHTTPClient, jobURL string) ([]JobHostSummaryAPIModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var summaries []JobHostSummaryAPIModel
+
+	summariesURL := path.Join(jobURL, "job_host_summaries")
+	for page := 1; ; page++ {
+		if !IsContextActive(ctx, "GetJobHostSummaries", &diags) {
+			return nil, diags
+		}
+
+		body, pageDiags := client.GetWithParams(summariesURL, map[string]string{
+			"page":      strconv.Itoa(page),
+			"page_size": strconv.Itoa(jobHostSummariesPageSize),
+		})
+		diags.Append(pageDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var summariesPage JobHostSummaryListAPIModel
+		err := json.Unmarshal(body, &summariesPage)
+		if err != nil {
+			diags.AddError("Error parsing JSON response from AAP", err.Error())
+			return nil, diags
+		}
+		summaries = append(summaries, summariesPage.Results...)
+
+		if summariesPage.Next == "" {
+			return summaries, diags
+		}
+	}
+}
+
+// GetJobStdout retrieves the standard output of a job as plain text. AAP replaces the output with a notice
+// when it exceeds its display limit, the output is then downloaded instead.
+func GetJobStdout(client ProviderHTTPClient, jobURL string) (string, diag.Diagnostics) {
+	stdoutURL := path.Join(jobURL, "stdout")
+	body, diags := client.GetWithParams(stdoutURL, map[string]string{"format": "txt"})
+	if diags.HasError() {
+		return "", diags
+	}
+	if !strings.HasPrefix(string(body), jobStdoutTooLargeNotice) {
+		return string(body), diags
+	}
+
+	body, downloadDiags := client.GetWithParams(stdoutURL, map[string]string{"format": "txt_download"})
+	diags.Append(downloadDiags...)
+	if diags.HasError() {
+		return "", diags
+	}
+	return string(body), diags
+}
+
+// GetJobStdoutTail retrieves the standard output of a job as plain text and returns its last lines.
+func GetJobStdoutTail(client ProviderHTTPClient, jobURL string, lines int64) (string, diag.Diagnostics) {
+	stdout, diags := GetJobStdout(client, jobURL)
+	if diags.HasError() {
+		return "", diags
+	}
+	return TailLines(stdout, lines), diags
+}
+
+// TailLines returns the last n lines of the provided text.
+func TailLines(text string, n int64) string {
+	text = strings.TrimRight(text, "\n")
+	if n <= 0 || text == "" {
+		return ""
+	}
+
+	lines := strings.Split(text, "\n")
+	if int64(len(lines)) > n {
+		lines = lines[int64(len(lines))-n:]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.uber.org/mock/gomock"
+)
+
+var reJobStdoutRecap = regexp.MustCompile(`PLAY RECAP`)
+
+func TestJobDataSourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	schemaRequest := datasource.SchemaRequest{}
+	schemaResponse := &datasource.SchemaResponse{}
+
+	// Instantiate the JobDataSource and call its Schema method
+	NewJobDataSource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestJobDataSourceParseHTTPResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected JobDataSourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: JobDataSourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name:  "pending job",
+			input: []byte(`{"id":1,"url":"/api/v2/jobs/1/","name":"Demo","status":"pending","failed":false,"job_template":7,"inventory":2,"launch_type":"scheduled","job_explanation":"","started":null,"finished":null,"elapsed":0,"artifacts":{}}`),
+			expected: JobDataSourceModel{
+				ID:             tftypes.Int64Value(1),
+				URL:            tftypes.StringValue("/api/v2/jobs/1/"),
+				Name:           tftypes.StringValue("Demo"),
+				Status:         tftypes.StringValue("pending"),
+				Failed:         tftypes.BoolValue(false),
+				JobTemplateID:  tftypes.Int64Value(7),
+				InventoryID:    tftypes.Int64Value(2),
+				LaunchType:     tftypes.StringValue("scheduled"),
+				JobExplanation: tftypes.StringNull(),
+				Started:        tftypes.StringNull(),
+				Finished:       tftypes.StringNull(),
+				Elapsed:        tftypes.Float64Value(0),
+				Artifacts:      tftypes.StringValue("{}"),
+			},
+			errors: diag.Diagnostics{},
+		},
+		{
+			name: "finished job",
+			input: []byte(`{"id":1,"url":"/api/v2/jobs/1/","name":"Demo","status":"failed","failed":true,"job_template":7,"inventory":2,` +
+				`"launch_type":"manual","job_explanation":"","started":"2025-01-01T10:00:00.000000Z","finished":"2025-01-01T10:00:12.500000Z",` +
+				`"elapsed":12.5,"artifacts":{ "b": [1, 2],  "a": "x" }}`),
+			expected: JobDataSourceModel{
+				ID:             tftypes.Int64Value(1),
+				URL:            tftypes.StringValue("/api/v2/jobs/1/"),
+				Name:           tftypes.StringValue("Demo"),
+				Status:         tftypes.StringValue("failed"),
+				Failed:         tftypes.BoolValue(true),
+				JobTemplateID:  tftypes.Int64Value(7),
+				InventoryID:    tftypes.Int64Value(2),
+				LaunchType:     tftypes.StringValue("manual"),
+				JobExplanation: tftypes.StringNull(),
+				Started:        tftypes.StringValue("2025-01-01T10:00:00.000000Z"),
+				Finished:       tftypes.StringValue("2025-01-01T10:00:12.500000Z"),
+				Elapsed:        tftypes.Float64Value(12.5),
+				Artifacts:      tftypes.StringValue(`{"a":"x","b":[1,2]}`),
+			},
+			errors: diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			dataSource := JobDataSourceModel{}
+			diags := dataSource.ParseHTTPResponse(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if !reflect.DeepEqual(test.expected, dataSource) {
+				t.Errorf("Expected (%v) not equal to actual (%v)", test.expected, dataSource)
+			}
+		})
+	}
+}
+
+func TestGetJobHostSummaries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockProviderHTTPClient(ctrl)
+	mockClient.EXPECT().GetWithParams("/api/v2/jobs/1/job_host_summaries", map[string]string{"page": "1", "page_size": "200"}).Return(
+		[]byte(`{"next":"/api/v2/jobs/1/job_host_summaries/?page=2","results":[{"host_name":"web1","ok":3,"changed":1}]}`),
+		diag.Diagnostics{},
+	)
+	mockClient.EXPECT().GetWithParams("/api/v2/jobs/1/job_host_summaries", map[string]string{"page": "2", "page_size": "200"}).Return(
+		[]byte(`{"next":null,"results":[{"host_name":"web2","ok":1,"failures":1,"dark":1,"failed":true}]}`),
+		diag.Diagnostics{},
+	)
+
+	summaries, diags := GetJobHostSummaries(t.Context(), mockClient, "/api/v2/jobs/1/")
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+	if len(summaries) != 2 {
+		t.Fatalf("Expected 2 host summaries, got %d", len(summaries))
+	}
+
+	dataSource := JobDataSourceModel{}
+	diags = dataSource.ParseHostSummaries(t.Context(), summaries)
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+	var parsed map[string]JobHostSummaryModel
+	diags = dataSource.HostSummaries.ElementsAs(t.Context(), &parsed, false)
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+	if parsed["web1"].Changed.ValueInt64() != 1 || parsed["web2"].Unreachable.ValueInt64() != 1 || parsed["web2"].Failed.ValueInt64() != 1 {
+		t.Errorf("Unexpected host summaries: %v", parsed)
+	}
+}
+
+func TestGetJobStdoutTail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockProviderHTTPClient(ctrl)
+	mockClient.EXPECT().GetWithParams("/api/v2/jobs/1/stdout", map[string]string{"format": "txt"}).Return(
+		[]byte("PLAY [all]\nTASK [ping]\nok: [web1]\nPLAY RECAP\n"),
+		diag.Diagnostics{},
+	)
+
+	stdout, diags := GetJobStdoutTail(mockClient, "/api/v2/jobs/1/", 2)
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+	if stdout != "ok: [web1]\nPLAY RECAP" {
+		t.Errorf("Unexpected stdout tail: %q", stdout)
+	}
+}
+
+func TestGetJobStdoutTooLarge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockProviderHTTPClient(ctrl)
+	gomock.InOrder(
+		mockClient.EXPECT().GetWithParams("/api/v2/jobs/1/stdout", map[string]string{"format": "txt"}).Return(
+			[]byte("Standard Output too large to display (2097152 bytes), only download supported for sizes over 1048576 bytes."),
+			diag.Diagnostics{},
+		),
+		mockClient.EXPECT().GetWithParams("/api/v2/jobs/1/stdout", map[string]string{"format": "txt_download"}).Return(
+			[]byte("PLAY [all]\nPLAY RECAP\n"),
+			diag.Diagnostics{},
+		),
+	)
+
+	stdout, diags := GetJobStdout(mockClient, "/api/v2/jobs/1/")
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+	if stdout != "PLAY [all]\nPLAY RECAP\n" {
+		t.Errorf("Unexpected stdout: %q", stdout)
+	}
+}
+
+func TestTailLines(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		text     string
+		lines    int64
+		expected string
+	}{
+		{name: "empty text", text: "", lines: 5, expected: ""},
+		{name: "fewer lines than requested", text: "a\nb\n", lines: 5, expected: "a\nb"},
+		{name: "more lines than requested", text: "a\nb\nc\nd", lines: 2, expected: "c\nd"},
+		{name: "no lines requested", text: "a\nb", lines: 0, expected: ""},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual := TailLines(test.text, test.lines)
+			if actual != test.expected {
+				t.Errorf("Expected (%q) not equal to actual (%q)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestAccJobDataSource(t *testing.T) {
+	jobTemplateID := os.Getenv("AAP_TEST_JOB_TEMPLATE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobDataSource(jobTemplateID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.aap_job.test", "url", "aap_job.test", "url"),
+					resource.TestCheckResourceAttr("data.aap_job.test", "status", statusSuccessfulConst),
+					resource.TestCheckResourceAttr("data.aap_job.test", "failed", "false"),
+					resource.TestCheckResourceAttr("data.aap_job.test", "job_template_id", jobTemplateID),
+					resource.TestCheckResourceAttrSet("data.aap_job.test", "started"),
+					resource.TestCheckResourceAttrSet("data.aap_job.test", "finished"),
+					resource.TestCheckResourceAttrSet("data.aap_job.test", "artifacts"),
+					resource.TestCheckResourceAttr("data.aap_job.test", "host_summaries.%", "1"),
+					resource.TestCheckResourceAttr("data.aap_job.test", "host_summaries.localhost.failed", "0"),
+					resource.TestMatchResourceAttr("data.aap_job.test", "stdout", reJobStdoutRecap),
+					resource.TestCheckResourceAttrSet("data.aap_job.test", "stdout_truncated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccJobDataSource(jobTemplateID string) string {
+	return fmt.Sprintf(`
+resource "aap_job" "test" {
+  job_template_id     = %s
+  wait_for_completion = true
+}
+
+data "aap_job" "test" {
+  id           = tonumber(regex("/jobs/([0-9]+)/", aap_job.test.url)[0])
+  stdout_lines = 10
+}
+`, jobTemplateID)
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// jobEventsTasks streams the play and task headers and the play recap.
+	jobEventsTasks = "tasks"
+	// jobEventsFailures streams the output of the failed and unreachable tasks.
+	jobEventsFailures = "failures"
+	// jobEventsStdout streams the full output of the job.
+	jobEventsStdout = "stdout"
+	// jobEventsPageSize is the number of job events retrieved with each request.
+	jobEventsPageSize = "200"
+)
+
+// jobEventsFilters are the accepted values of the stream_job_events attribute.
+var jobEventsFilters = []string{jobEventsTasks, jobEventsFailures, jobEventsStdout}
+
+var (
+	jobTaskEvents = map[string]bool{
+		"playbook_on_play_start": true,
+		"playbook_on_task_start": true,
+		"playbook_on_stats":      true,
+	}
+	jobFailureEvents = map[string]bool{
+		"runner_on_failed":       true,
+		"runner_on_unreachable":  true,
+		"runner_item_on_failed":  true,
+		"runner_on_async_failed": true,
+	}
+	reANSIEscape = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+)
+
+// JobEventAPIModel represents an event of a job. /api/controller/v2/jobs/<id>/job_events/
+type JobEventAPIModel struct {
+	Counter  int64  `json:"counter"`
+	Event    string `json:"event"`
+	Stdout   string `json:"stdout"`
+	HostName string `json:"host_name"`
+	Changed  bool   `json:"changed"`
+}
+
+// JobEventListAPIModel represents a page of job events.
+type JobEventListAPIModel struct {
+	Next    string             `json:"next"`
+	Results []JobEventAPIModel `json:"results"`
+}
+
+// JobEventsStreamer sends the output of the events of a running job.
+type JobEventsStreamer interface {
+	// Stream calls send with the output of each event that was not sent yet.
+	Stream(send func(message string)) diag.Diagnostics
+}
+
+// JobEventStreamer retrieves the events of a job incrementally, using the counter of the last event it saw.
+type JobEventStreamer struct {
+	client    ProviderHTTPClient
+	eventsURL string
+	filter    string
+	counter   int64
+}
+
+// NewJobEventStreamer returns a streamer of the events of the job at the given URL matching the filter.
+func NewJobEventStreamer(client ProviderHTTPClient, jobURL string, filter string) *JobEventStreamer {
+	return &JobEventStreamer{
+		client:    client,
+		eventsURL: path.Join(jobURL, "job_events"),
+		filter:    filter,
+	}
+}
+
+// Stream calls send with the output of each new job event matching the filter, in order.
+func (s *JobEventStreamer) Stream(send func(message string)) diag.Diagnostics {
+	for {
+		body, diags := s.client.GetWithParams(s.eventsURL, map[string]string{
+			"counter__gt": strconv.FormatInt(s.counter, 10),
+			"order_by":    "counter",
+			"page_size":   jobEventsPageSize,
+		})
+		if diags.HasError() {
+			return diags
+		}
+
+		var events JobEventListAPIModel
+		err := json.Unmarshal(body, &events)
+		if err != nil {
+			diags.AddError("Error parsing JSON response from AAP", err.Error())
+			return diags
+		}
+
+		for _, event := range events.Results {
+			s.counter = event.Counter
+			if message := FormatJobEvent(event, s.filter); message != "" {
+				send(message)
+			}
+		}
+
+		if events.Next == "" || len(events.Results) == 0 {
+			return diags
+		}
+	}
+}
+
+// FormatJobEvent returns the output of the job event without color codes,
+// or an empty string when the event does not match the filter.
+func FormatJobEvent(event JobEventAPIModel, filter string) string {
+	switch filter {
+	case jobEventsTasks:
+		if !jobTaskEvents[event.Event] {
+			return ""
+		}
+	case jobEventsFailures:
+		if !jobFailureEvents[event.Event] {
+			return ""
+		}
+	}
+	return strings.TrimSpace(reANSIEscape.ReplaceAllString(event.Stdout, ""))
+}
+
+// WorkflowJobEventStreamer streams the events of the jobs launched by a workflow job, as they are started.
+type WorkflowJobEventStreamer struct {
+	client   ProviderHTTPClient
+	nodesURL string
+	filter   string
+	jobs     map[int64]*JobEventStreamer
+}
+
+// NewWorkflowJobEventStreamer returns a streamer of the events of the jobs of the workflow job at the given URL.
+func NewWorkflowJobEventStreamer(client ProviderHTTPClient, workflowJobURL string, filter string) *WorkflowJobEventStreamer {
+	return &WorkflowJobEventStreamer{
+		client:   client,
+		nodesURL: path.Join(workflowJobURL, "workflow_nodes"),
+		filter:   filter,
+		jobs:     map[int64]*JobEventStreamer{},
+	}
+}
+
+// Stream calls send with the output of each new event of the jobs of the workflow, prefixed with the job name.
+func (s *WorkflowJobEventStreamer) Stream(send func(message string)) diag.Diagnostics {
+	body, diags := s.client.GetWithParams(s.nodesURL, map[string]string{"order_by": "id", "page_size": jobEventsPageSize})
+	if diags.HasError() {
+		return diags
+	}
+
+	var nodes WorkflowJobNodeListAPIModel
+	err := json.Unmarshal(body, &nodes)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	for _, node := range nodes.Results {
+		job := node.SummaryFields.Job
+		// Only playbook runs have job events, nodes may also run project or inventory updates
+		if job.ID == 0 || job.Type != "job" {
+			continue
+		}
+		streamer, ok := s.jobs[job.ID]
+		if !ok {
+			streamer = NewJobEventStreamer(s.client, path.Join(s.client.getAPIEndpoint(), "jobs", strconv.FormatInt(job.ID, 10)), s.filter)
+			s.jobs[job.ID] = streamer
+		}
+		diags.Append(streamer.Stream(func(message string) {
+			send(fmt.Sprintf("[%s] %s", job.Name, message))
+		})...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	return diags
+}
+
+// StreamJobEvents sends the new events of a job, a failure to retrieve them is only logged
+// since it does not affect the job itself. Nothing is sent when the streamer is nil.
+func StreamJobEvents(ctx context.Context, streamer JobEventsStreamer, send func(message string)) {
+	if streamer == nil {
+		return
+	}
+	diags := streamer.Stream(send)
+	if diags.HasError() {
+		tflog.Warn(ctx, "Could not retrieve the job events", map[string]interface{}{
+			"errors": diags.Errors(),
+		})
+	}
+}
+
+// newJobEventsStreamer returns a streamer of the events of a job, or nil when stream_job_events is not set.
+func newJobEventsStreamer(client ProviderHTTPClient, jobURL string, filter types.String) JobEventsStreamer {
+	if !IsValueProvided(filter) {
+		return nil
+	}
+	return NewJobEventStreamer(client, jobURL, filter.ValueString())
+}
+
+// newWorkflowJobEventsStreamer returns a streamer of the events of the jobs of a workflow job,
+// or nil when stream_job_events is not set.
+func newWorkflowJobEventsStreamer(client ProviderHTTPClient, workflowJobURL string, filter types.String) JobEventsStreamer {
+	if !IsValueProvided(filter) {
+		return nil
+	}
+	return NewWorkflowJobEventStreamer(client, workflowJobURL, filter.ValueString())
+}
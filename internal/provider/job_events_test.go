@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFormatJobEvent(t *testing.T) {
+	taskStart := JobEventAPIModel{Event: "playbook_on_task_start", Stdout: "\r\nTASK [ping] *****"}
+	taskOk := JobEventAPIModel{Event: "runner_on_ok", Stdout: "\x1b[0;32mok: [web1]\x1b[0m"}
+	taskFailed := JobEventAPIModel{Event: "runner_on_failed", Stdout: "\x1b[0;31mfatal: [web2]: FAILED!\x1b[0m"}
+
+	var testTable = []struct {
+		name     string
+		event    JobEventAPIModel
+		filter   string
+		expected string
+	}{
+		{name: "task start with tasks filter", event: taskStart, filter: jobEventsTasks, expected: "TASK [ping] *****"},
+		{name: "task result with tasks filter", event: taskOk, filter: jobEventsTasks, expected: ""},
+		{name: "task start with failures filter", event: taskStart, filter: jobEventsFailures, expected: ""},
+		{name: "failed task with failures filter", event: taskFailed, filter: jobEventsFailures, expected: "fatal: [web2]: FAILED!"},
+		{name: "task result with stdout filter", event: taskOk, filter: jobEventsStdout, expected: "ok: [web1]"},
+		{name: "event without output", event: JobEventAPIModel{Event: "verbose"}, filter: jobEventsStdout, expected: ""},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual := FormatJobEvent(test.event, test.filter)
+			if actual != test.expected {
+				t.Errorf("Expected (%q) not equal to actual (%q)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestJobEventStreamer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventsURL := "/api/v2/jobs/1/job_events"
+	params := func(counter string) map[string]string {
+		return map[string]string{"counter__gt": counter, "order_by": "counter", "page_size": "200"}
+	}
+
+	mockClient := NewMockProviderHTTPClient(ctrl)
+	gomock.InOrder(
+		// The first call reads two pages of events
+		mockClient.EXPECT().GetWithParams(eventsURL, params("0")).Return(
+			[]byte(`{"next":"/api/v2/jobs/1/job_events/?page=2","results":[`+
+				`{"counter":1,"event":"playbook_on_start","stdout":""},`+
+				`{"counter":2,"event":"playbook_on_play_start","stdout":"PLAY [all]"}]}`),
+			diag.Diagnostics{},
+		),
+		mockClient.EXPECT().GetWithParams(eventsURL, params("2")).Return(
+			[]byte(`{"next":null,"results":[{"counter":3,"event":"playbook_on_task_start","stdout":"TASK [ping]"}]}`),
+			diag.Diagnostics{},
+		),
+		// The next call only reads the events recorded since then
+		mockClient.EXPECT().GetWithParams(eventsURL, params("3")).Return(
+			[]byte(`{"next":null,"results":[{"counter":4,"event":"runner_on_ok","stdout":"ok: [web1]"},`+
+				`{"counter":5,"event":"playbook_on_stats","stdout":"PLAY RECAP"}]}`),
+			diag.Diagnostics{},
+		),
+	)
+
+	streamer := NewJobEventStreamer(mockClient, "/api/v2/jobs/1/", jobEventsTasks)
+	var messages []string
+	send := func(message string) { messages = append(messages, message) }
+
+	for range 2 {
+		diags := streamer.Stream(send)
+		if diags.HasError() {
+			t.Fatal(diags.Errors())
+		}
+	}
+
+	expected := []string{"PLAY [all]", "TASK [ping]", "PLAY RECAP"}
+	if !reflect.DeepEqual(expected, messages) {
+		t.Errorf("Expected (%v) not equal to actual (%v)", expected, messages)
+	}
+}
+
+func TestWorkflowJobEventStreamer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockProviderHTTPClient(ctrl)
+	mockClient.EXPECT().GetWithParams("/api/v2/workflow_jobs/1/workflow_nodes", map[string]string{"order_by": "id", "page_size": "200"}).Return(
+		[]byte(`{"results":[`+
+			`{"summary_fields":{"job":{"id":2,"name":"Update project","status":"successful","type":"project_update"}}},`+
+			`{"summary_fields":{"job":{"id":3,"name":"Deploy","status":"running","type":"job"}}},`+
+			`{"summary_fields":{}}]}`),
+		diag.Diagnostics{},
+	)
+	mockClient.EXPECT().getAPIEndpoint().Return("/api/v2")
+	mockClient.EXPECT().GetWithParams("/api/v2/jobs/3/job_events", map[string]string{"counter__gt": "0", "order_by": "counter", "page_size": "200"}).Return(
+		[]byte(`{"next":null,"results":[{"counter":1,"event":"runner_on_failed","stdout":"fatal: [web1]: FAILED!"}]}`),
+		diag.Diagnostics{},
+	)
+
+	streamer := NewWorkflowJobEventStreamer(mockClient, "/api/v2/workflow_jobs/1/", jobEventsFailures)
+	var messages []string
+	diags := streamer.Stream(func(message string) { messages = append(messages, message) })
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+
+	expected := []string{"[Deploy] fatal: [web1]: FAILED!"}
+	if !reflect.DeepEqual(expected, messages) {
+		t.Errorf("Expected (%v) not equal to actual (%v)", expected, messages)
+	}
+}
+
+func TestNewJobEventsStreamer(t *testing.T) {
+	if streamer := newJobEventsStreamer(nil, "/api/v2/jobs/1/", tftypes.StringNull()); streamer != nil {
+		t.Errorf("Expected no streamer when stream_job_events is not set, got %v", streamer)
+	}
+	if streamer := newWorkflowJobEventsStreamer(nil, "/api/v2/workflow_jobs/1/", tftypes.StringNull()); streamer != nil {
+		t.Errorf("Expected no streamer when stream_job_events is not set, got %v", streamer)
+	}
+	if streamer := newJobEventsStreamer(nil, "/api/v2/jobs/1/", tftypes.StringValue(jobEventsStdout)); streamer == nil {
+		t.Error("Expected a streamer when stream_job_events is set")
+	}
+}
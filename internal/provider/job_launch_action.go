@@ -8,6 +8,7 @@ import (
 
 	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/action/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -27,7 +28,8 @@ func NewJobAction() action.Action {
 }
 
 var (
-	_ action.Action = (*JobAction)(nil)
+	_ action.Action                     = (*JobAction)(nil)
+	_ action.ActionWithConfigValidators = (*JobAction)(nil)
 )
 
 type JobActionModel struct {
@@ -40,8 +42,17 @@ func (a *JobAction) Schema(_ context.Context, _ action.SchemaRequest, resp *acti
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"job_template_id": schema.Int64Attribute{
-				Required:    true,
-				Description: "ID of the job template.",
+				Optional:    true,
+				Description: "ID of the job template. Required unless `job_template_name` and `organization_name` are provided.",
+			},
+			"job_template_name": schema.StringAttribute{
+				Optional: true,
+				Description: "Name of the job template, to reference it together with `organization_name` instead of " +
+					"`job_template_id`.",
+			},
+			"organization_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the organization of the job template referenced by `job_template_name`.",
 			},
 			"inventory_id": schema.Int64Attribute{
 				Optional: true,
@@ -51,7 +62,7 @@ func (a *JobAction) Schema(_ context.Context, _ action.SchemaRequest, resp *acti
 			"extra_vars": schema.StringAttribute{
 				Description: "Extra Variables. Must be provided as either a JSON or YAML string.",
 				Optional:    true,
-				CustomType:  customtypes.AAPCustomStringType{},
+				CustomType:  customtypes.AAPCustomVariablesType{},
 			},
 			"limit": schema.StringAttribute{
 				Description: "Limit pattern to restrict the job run to specific hosts.",
@@ -115,6 +126,15 @@ func (a *JobAction) Schema(_ context.Context, _ action.SchemaRequest, resp *acti
 				Description: "When this is set to `true`, Terraform will wait until this aap_job resource is created, reaches " +
 					"any final status and then, proceeds with the following resource operation",
 			},
+			"stream_job_events": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(jobEventsFilters...),
+				},
+				Description: "When `wait_for_completion` is `true`, reports the output of the job as progress while it runs: " +
+					"`tasks` for the play and task names and the play recap, `failures` for the failed tasks only, " +
+					"or `stdout` for the full output.",
+			},
 			"wait_for_completion_timeout_seconds": schema.Int64Attribute{
 				Optional: true,
 				Description: "Sets the maximum amount of seconds Terraform will wait before timing out the updates, " +
@@ -133,6 +153,11 @@ func (a *JobAction) Schema(_ context.Context, _ action.SchemaRequest, resp *acti
 	}
 }
 
+// ConfigValidators returns the configuration validators of the job action.
+func (a *JobAction) ConfigValidators(_ context.Context) []action.ConfigValidator {
+	return templateActionConfigValidators("job_template_id", "job_template_name")
+}
+
 // Invoke executes the job action.
 func (a *JobAction) Invoke(ctx context.Context, req action.InvokeRequest, response *action.InvokeResponse) {
 	var config JobActionModel
@@ -183,16 +208,23 @@ func (a *JobAction) Invoke(ctx context.Context, req action.InvokeRequest, respon
 		timeout := time.Duration(config.WaitForCompletionTimeout.ValueInt64()) * time.Second
 		var status string
 
+		streamer := newJobEventsStreamer(a.client, jobResponse.URL, config.StreamJobEvents)
+		sendJobEvent := func(message string) {
+			response.SendProgress(action.InvokeProgressEvent{Message: message})
+		}
 		retryProgressFunc := func(status string) {
 			response.SendProgress(action.InvokeProgressEvent{
 				Message: fmt.Sprintf("Job at: %s is in status: %s", jobResponse.URL, status),
 			})
+			StreamJobEvents(ctx, streamer, sendJobEvent)
 		}
 		err := retry.RetryContext(
 			ctx,
 			timeout,
 			retryUntilAAPJobReachesAnyFinalState(ctx, a.client, retryProgressFunc, jobResponse.URL, &status),
 		)
+		// Events can still be recorded once the job reached a final state
+		StreamJobEvents(ctx, streamer, sendJobEvent)
 		if err != nil {
 			response.Diagnostics.Append(diag.NewErrorDiagnostic("error when waiting for AAP job to complete", err.Error()))
 			return
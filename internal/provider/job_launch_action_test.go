@@ -14,6 +14,7 @@ import (
 	"testing"
 
 	fwaction "github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -125,11 +126,14 @@ func TestJobLaunchActionConfigure(t *testing.T) {
 // jobActionConfigOverrides allows overriding specific config values
 type jobActionConfigOverrides struct {
 	TemplateID               *int64
+	TemplateName             *string
+	OrganizationName         *string
 	InventoryID              *int64
 	ExtraVars                *string
 	WaitForCompletion        *bool
 	WaitForCompletionTimeout *int64
 	IgnoreJobResults         *bool
+	StreamJobEvents          *string
 }
 
 // valueOrNil returns a tftypes.Value with the given value if non-nil, otherwise a typed nil
@@ -144,11 +148,14 @@ func valueOrNil[T any](tfType tftypes.Type, value *T) tftypes.Value {
 func createJobActionConfig(overrides jobActionConfigOverrides) map[string]tftypes.Value {
 	return map[string]tftypes.Value{
 		"job_template_id":                     valueOrNil(tftypes.Number, overrides.TemplateID),
+		"job_template_name":                   valueOrNil(tftypes.String, overrides.TemplateName),
+		"organization_name":                   valueOrNil(tftypes.String, overrides.OrganizationName),
 		"inventory_id":                        valueOrNil(tftypes.Number, overrides.InventoryID),
 		"extra_vars":                          valueOrNil(tftypes.String, overrides.ExtraVars),
 		"wait_for_completion":                 valueOrNil(tftypes.Bool, overrides.WaitForCompletion),
 		"wait_for_completion_timeout_seconds": valueOrNil(tftypes.Number, overrides.WaitForCompletionTimeout),
 		"ignore_job_results":                  valueOrNil(tftypes.Bool, overrides.IgnoreJobResults),
+		"stream_job_events":                   valueOrNil(tftypes.String, overrides.StreamJobEvents),
 		"limit":                               valueOrNil[string](tftypes.String, nil),
 		"job_tags":                            valueOrNil[string](tftypes.String, nil),
 		"skip_tags":                           valueOrNil[string](tftypes.String, nil),
@@ -293,6 +300,9 @@ func TestJobLaunchActionInvoke(t *testing.T) {
 	waitFalse := false
 	ignoreTrue := true
 	ignoreFalse := false
+	streamTasks := jobEventsTasks
+	templateName := "Deploy app"
+	organizationName := "Default"
 
 	testTable := []struct {
 		name             string
@@ -394,6 +404,58 @@ func TestJobLaunchActionInvoke(t *testing.T) {
 			expectError:      true,
 			expectedErrorMsg: "AAP job canceled",
 		},
+		{
+			name: "wait for completion - streams job events",
+			configOverrides: jobActionConfigOverrides{
+				TemplateID:               &templateID,
+				WaitForCompletion:        &waitTrue,
+				WaitForCompletionTimeout: &timeout,
+				StreamJobEvents:          &streamTasks,
+			},
+			setupMock: func(mock *MockProviderHTTPClient) {
+				mockSuccessfulJobLaunch(mock)
+				mock.EXPECT().Get("/api/v2/jobs/789/").Return([]byte(`{"status": "successful"}`), nil)
+				gomock.InOrder(
+					mock.EXPECT().GetWithParams("/api/v2/jobs/789/job_events", map[string]string{
+						"counter__gt": "0", "order_by": "counter", "page_size": "200",
+					}).Return([]byte(`{"next":null,"results":[{"counter":1,"event":"playbook_on_task_start","stdout":"TASK [ping]"}]}`), nil),
+					mock.EXPECT().GetWithParams("/api/v2/jobs/789/job_events", map[string]string{
+						"counter__gt": "1", "order_by": "counter", "page_size": "200",
+					}).Return([]byte(`{"next":null,"results":[{"counter":2,"event":"playbook_on_stats","stdout":"PLAY RECAP"}]}`), nil),
+				)
+			},
+			expectError: false,
+		},
+		{
+			name: "launch by template name",
+			configOverrides: jobActionConfigOverrides{
+				TemplateName:      &templateName,
+				OrganizationName:  &organizationName,
+				WaitForCompletion: &waitFalse,
+			},
+			setupMock: func(mock *MockProviderHTTPClient) {
+				mock.EXPECT().getAPIEndpoint().Return("/api/v2")
+				mock.EXPECT().Get("/api/v2/job_templates/Deploy app++Default").Return([]byte(`{"id": 123}`), nil)
+				mockSuccessfulJobLaunch(mock)
+			},
+			expectError: false,
+		},
+		{
+			name: "launch by unknown template name",
+			configOverrides: jobActionConfigOverrides{
+				TemplateName:      &templateName,
+				OrganizationName:  &organizationName,
+				WaitForCompletion: &waitFalse,
+			},
+			setupMock: func(mock *MockProviderHTTPClient) {
+				mock.EXPECT().getAPIEndpoint().Return("/api/v2")
+				mock.EXPECT().Get("/api/v2/job_templates/Deploy app++Default").Return(nil, diag.Diagnostics{
+					diag.NewErrorDiagnostic("Client error", "Not found"),
+				})
+			},
+			expectError:      true,
+			expectedErrorMsg: "Not found",
+		},
 		{
 			name: "wait for completion - uses default timeout",
 			configOverrides: jobActionConfigOverrides{
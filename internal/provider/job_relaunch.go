@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	// relaunchHostsAll relaunches the job on all the hosts of the original job.
+	relaunchHostsAll = "all"
+	// relaunchHostsFailed relaunches the job only on the hosts that failed in the original job.
+	relaunchHostsFailed = "failed"
+)
+
+// relaunchHostsValues are the accepted values of the hosts attributes of the relaunches.
+var relaunchHostsValues = []string{relaunchHostsAll, relaunchHostsFailed}
+
+// JobRelaunchRequestModel represents the request body of the relaunch endpoint.
+// POST /api/controller/v2/jobs/<id>/relaunch/
+type JobRelaunchRequestModel struct {
+	Hosts string `json:"hosts,omitempty"`
+}
+
+// JobRelaunchOptions are the options of the relaunches of a job.
+type JobRelaunchOptions struct {
+	// Hosts are the hosts the job is relaunched on, all or failed.
+	Hosts string
+	// Attempts is the maximum number of times the job is relaunched.
+	Attempts int64
+	// Delay is the time waited before relaunching a job that did not succeed.
+	Delay time.Duration
+	// Timeout is the time waited for each relaunched job to reach a final state.
+	Timeout time.Duration
+}
+
+// JobRelaunchProgressFunc is called with the attempt number and the status of each relaunched job.
+type JobRelaunchProgressFunc func(attempt int64, jobURL string, status string)
+
+// RelaunchAAPJob relaunches the job at the given URL on the given hosts and returns the new job.
+func RelaunchAAPJob(client ProviderHTTPClient, jobURL string, hosts string) (JobAPIModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var job JobAPIModel
+
+	requestBody, err := json.Marshal(JobRelaunchRequestModel{Hosts: hosts})
+	if err != nil {
+		diags.AddError("Error marshaling request body", err.Error())
+		return job, diags
+	}
+
+	resp, body, err := client.doRequest(http.MethodPost, path.Join(jobURL, "relaunch"), nil, bytes.NewReader(requestBody))
+	diags.Append(ValidateResponse(resp, body, err, []int{http.StatusCreated})...)
+	if diags.HasError() {
+		return job, diags
+	}
+
+	err = json.Unmarshal(body, &job)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+	}
+	return job, diags
+}
+
+// RelaunchHosts returns the hosts the job at the given URL is relaunched on. AAP only relaunches the failed
+// hosts of a job that failed on some of its hosts, a job that ended in error, was canceled or has no failed
+// hosts is relaunched on all its hosts instead. The status is the one of the job, it is retrieved when empty.
+func RelaunchHosts(ctx context.Context, client ProviderHTTPClient, jobURL string, status string, hosts string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if hosts != relaunchHostsFailed {
+		return hosts, diags
+	}
+
+	if status == "" {
+		body, getDiags := client.Get(jobURL)
+		diags.Append(getDiags...)
+		if diags.HasError() {
+			return hosts, diags
+		}
+		var job JobAPIModel
+		err := json.Unmarshal(body, &job)
+		if err != nil {
+			diags.AddError("Error parsing JSON response from AAP", err.Error())
+			return hosts, diags
+		}
+		status = job.Status
+	}
+	if status != statusFailedConst {
+		return relaunchHostsAll, diags
+	}
+
+	summaries, summariesDiags := GetJobHostSummaries(ctx, client, jobURL)
+	diags.Append(summariesDiags...)
+	if diags.HasError() {
+		return hosts, diags
+	}
+	for _, summary := range summaries {
+		if summary.Failed || summary.Failures > 0 || summary.Dark > 0 {
+			return hosts, diags
+		}
+	}
+	return relaunchHostsAll, diags
+}
+
+// RelaunchAAPJobUntilSuccessful relaunches the job at the given URL and waits for the new job to reach
+// a final state, until a job succeeds or the attempts are exhausted. The status is the one of the given
+// job, the delay is only waited before relaunching a job whose status is known. It returns the URL and
+// the status of the last job.
+func RelaunchAAPJobUntilSuccessful(
+	ctx context.Context,
+	client ProviderHTTPClient,
+	jobURL string,
+	status string,
+	options JobRelaunchOptions,
+	progress JobRelaunchProgressFunc,
+) (string, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	for attempt := int64(1); attempt <= options.Attempts && status != statusSuccessfulConst; attempt++ {
+		if status != "" && options.Delay > 0 {
+			select {
+			case <-ctx.Done():
+				diags.AddError("error when relaunching AAP job", ctx.Err().Error())
+				return jobURL, status, diags
+			case <-time.After(options.Delay):
+			}
+		}
+
+		hosts, hostsDiags := RelaunchHosts(ctx, client, jobURL, status, options.Hosts)
+		diags.Append(hostsDiags...)
+		if diags.HasError() {
+			return jobURL, status, diags
+		}
+		job, relaunchDiags := RelaunchAAPJob(client, jobURL, hosts)
+		diags.Append(relaunchDiags...)
+		if diags.HasError() {
+			return jobURL, status, diags
+		}
+		jobURL, status = job.URL, job.Status
+
+		retryProgressFunc := func(status string) {
+			tflog.Debug(ctx, "Job status update", map[string]interface{}{
+				"status": status,
+				"url":    jobURL,
+			})
+		}
+		err := retry.RetryContext(ctx, options.Timeout, retryUntilAAPJobReachesAnyFinalState(ctx, client, retryProgressFunc, jobURL, &status))
+		if err != nil {
+			diags.AddError("error when waiting for relaunched AAP job to complete", err.Error())
+			return jobURL, status, diags
+		}
+		progress(attempt, jobURL, status)
+	}
+
+	return jobURL, status, diags
+}
+
+// describeRelaunchAttempt describes the outcome of a relaunch attempt, used to report the progress.
+func describeRelaunchAttempt(attempt int64, attempts int64, jobURL string, status string) string {
+	return fmt.Sprintf("Relaunch attempt %d of %d, job at: %s is in status: %s", attempt, attempts, jobURL, status)
+}
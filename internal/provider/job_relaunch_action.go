@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// JobRelaunchAction represents an action relaunching a job in AAP.
+type JobRelaunchAction struct {
+	client ProviderHTTPClient
+}
+
+func NewJobRelaunchAction() action.Action {
+	return &JobRelaunchAction{}
+}
+
+var (
+	_ action.Action = (*JobRelaunchAction)(nil)
+)
+
+type JobRelaunchActionModel struct {
+	JobID                    types.Int64  `tfsdk:"job_id"`
+	Hosts                    types.String `tfsdk:"hosts"`
+	WaitForCompletion        types.Bool   `tfsdk:"wait_for_completion"`
+	WaitForCompletionTimeout types.Int64  `tfsdk:"wait_for_completion_timeout_seconds"`
+	Retries                  types.Int64  `tfsdk:"retries"`
+	RetryDelay               types.Int64  `tfsdk:"retry_delay_seconds"`
+	IgnoreJobResults         types.Bool   `tfsdk:"ignore_job_results"`
+}
+
+// Schema defines the schema for the job relaunch action
+func (a *JobRelaunchAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"job_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "ID of the job to relaunch.",
+			},
+			"hosts": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(relaunchHostsValues...),
+				},
+				Description: "The hosts the job is relaunched on: `all` the hosts of the job, or only the hosts that `failed`. " +
+					"Default value of `failed`. A job that did not fail on any host, such as a job in error or canceled, " +
+					"is relaunched on all its hosts.",
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+				Description: "When this is set to `true`, Terraform will wait until the relaunched job reaches " +
+					"any final status and then, proceeds with the following resource operation",
+			},
+			"wait_for_completion_timeout_seconds": schema.Int64Attribute{
+				Optional: true,
+				Description: "Sets the maximum amount of seconds Terraform will wait for each relaunched job " +
+					"to reach a final status. Default value of `120`",
+			},
+			"retries": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Description: "When `wait_for_completion` is `true`, the number of times the job is relaunched again " +
+					"while it does not succeed. Default value of `0`.",
+			},
+			"retry_delay_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Description: "The amount of seconds to wait before relaunching a job that did not succeed. Default value of `0`.",
+			},
+			"ignore_job_results": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "When this is set to `true`, and wait_for_completion is `true`, ignore the job status.",
+			},
+		},
+		MarkdownDescription: "Relaunches an AAP job.\n\n" +
+			"This action creates a new job in AAP from an existing job, on all its hosts or only on the hosts that failed. \n" +
+			"Moreover, you can set `wait_for_completion` to true, then Terraform will " +
+			"wait until the relaunched job reaches any final state before continuing, and relaunch it again " +
+			"up to `retries` times while it does not succeed. Each attempt is reported as progress.",
+	}
+}
+
+// Invoke executes the job relaunch action.
+func (a *JobRelaunchAction) Invoke(ctx context.Context, req action.InvokeRequest, response *action.InvokeResponse) {
+	var config JobRelaunchActionModel
+
+	response.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set default timeout if not provided
+	if config.WaitForCompletionTimeout.IsNull() {
+		config.WaitForCompletionTimeout = types.Int64Value(waitForCompletionTimeoutDefault)
+	}
+	// Relaunch only the failed hosts by default, like the relaunch_on attribute of the job resource
+	if config.Hosts.IsNull() {
+		config.Hosts = types.StringValue(relaunchHostsFailed)
+	}
+
+	jobURL := path.Join(a.client.getAPIEndpoint(), "jobs", config.JobID.String())
+
+	if !config.WaitForCompletion.ValueBool() {
+		hosts, diags := RelaunchHosts(ctx, a.client, jobURL, "", config.Hosts.ValueString())
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		job, diags := RelaunchAAPJob(a.client, jobURL, hosts)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Job relaunched, URL: %s", job.URL),
+		})
+		return
+	}
+
+	options := JobRelaunchOptions{
+		Hosts:    config.Hosts.ValueString(),
+		Attempts: 1 + config.Retries.ValueInt64(),
+		Delay:    time.Duration(config.RetryDelay.ValueInt64()) * time.Second,
+		Timeout:  time.Duration(config.WaitForCompletionTimeout.ValueInt64()) * time.Second,
+	}
+	progress := func(attempt int64, jobURL string, status string) {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: describeRelaunchAttempt(attempt, options.Attempts, jobURL, status),
+		})
+	}
+	jobURL, status, diags := RelaunchAAPJobUntilSuccessful(ctx, a.client, jobURL, "", options, progress)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if status != statusSuccessfulConst {
+		if config.IgnoreJobResults.ValueBool() {
+			response.Diagnostics.Append(
+				diag.NewWarningDiagnostic(
+					fmt.Sprintf("AAP job %s", status),
+					fmt.Sprintf("API Path: %s", jobURL),
+				),
+			)
+		} else {
+			response.Diagnostics.Append(
+				diag.NewErrorDiagnostic(
+					fmt.Sprintf("AAP job %s", status),
+					fmt.Sprintf("API Path: %s", jobURL),
+				),
+			)
+		}
+	}
+}
+
+// Configure configures the job relaunch action with the provider client
+func (a *JobRelaunchAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+// Metadata returns the action metadata
+func (a *JobRelaunchAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_relaunch"
+}
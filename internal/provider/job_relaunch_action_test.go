@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	fwaction "github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.uber.org/mock/gomock"
+)
+
+// TestJobRelaunchActionSchema tests the Schema function
+func TestJobRelaunchActionSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	schemaResponse := fwaction.SchemaResponse{}
+	NewJobRelaunchAction().Schema(ctx, fwaction.SchemaRequest{}, &schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+// TestJobRelaunchActionMetadata tests the Metadata function
+func TestJobRelaunchActionMetadata(t *testing.T) {
+	t.Parallel()
+
+	metadataResponse := fwaction.MetadataResponse{}
+	NewJobRelaunchAction().Metadata(t.Context(), fwaction.MetadataRequest{ProviderTypeName: "aap"}, &metadataResponse)
+
+	expected := "aap_job_relaunch"
+	if metadataResponse.TypeName != expected {
+		t.Errorf("Expected metadata TypeName %q, received %q", expected, metadataResponse.TypeName)
+	}
+}
+
+// TestJobRelaunchActionInvoke tests the full Invoke function
+func TestJobRelaunchActionInvoke(t *testing.T) {
+	// mockFailedJob mocks the job to relaunch, which failed on some of its hosts
+	mockFailedJob := func(mock *MockProviderHTTPClient) {
+		mock.EXPECT().Get("/api/v2/jobs/1").Return([]byte(`{"status": "failed"}`), diag.Diagnostics{})
+		mockJobHostSummaries(mock, 1, true)
+	}
+
+	jobID := int64(1)
+	hostsAll := relaunchHostsAll
+	waitTrue := true
+	retries := int64(1)
+	ignoreTrue := true
+
+	testTable := []struct {
+		name             string
+		hosts            *string
+		wait             *bool
+		retries          *int64
+		ignoreJobResults *bool
+		setupMock        func(*MockProviderHTTPClient)
+		expectedProgress []string
+		expectError      bool
+		expectWarning    bool
+	}{
+		{
+			name:  "relaunch without waiting",
+			hosts: &hostsAll,
+			setupMock: func(mock *MockProviderHTTPClient) {
+				mockJobRelaunch(mock, 1, relaunchHostsAll, 2)
+			},
+			expectedProgress: []string{"Job relaunched, URL: /api/v2/jobs/2/"},
+		},
+		{
+			name: "relaunch a canceled job on all hosts",
+			setupMock: func(mock *MockProviderHTTPClient) {
+				mock.EXPECT().Get("/api/v2/jobs/1").Return([]byte(`{"status": "canceled"}`), diag.Diagnostics{})
+				mockJobRelaunch(mock, 1, relaunchHostsAll, 2)
+			},
+			expectedProgress: []string{"Job relaunched, URL: /api/v2/jobs/2/"},
+		},
+		{
+			name:    "relaunch until successful",
+			wait:    &waitTrue,
+			retries: &retries,
+			setupMock: func(mock *MockProviderHTTPClient) {
+				mockFailedJob(mock)
+				mockJobRelaunch(mock, 1, relaunchHostsFailed, 2)
+				mock.EXPECT().Get("/api/v2/jobs/2/").Return([]byte(`{"status": "failed"}`), diag.Diagnostics{})
+				mockJobHostSummaries(mock, 2, true)
+				mockJobRelaunch(mock, 2, relaunchHostsFailed, 3)
+				mock.EXPECT().Get("/api/v2/jobs/3/").Return([]byte(`{"status": "successful"}`), diag.Diagnostics{})
+			},
+			expectedProgress: []string{
+				"Relaunch attempt 1 of 2, job at: /api/v2/jobs/2/ is in status: failed",
+				"Relaunch attempt 2 of 2, job at: /api/v2/jobs/3/ is in status: successful",
+			},
+		},
+		{
+			name: "relaunched job fails",
+			wait: &waitTrue,
+			setupMock: func(mock *MockProviderHTTPClient) {
+				mockFailedJob(mock)
+				mockJobRelaunch(mock, 1, relaunchHostsFailed, 2)
+				mock.EXPECT().Get("/api/v2/jobs/2/").Return([]byte(`{"status": "failed"}`), diag.Diagnostics{})
+			},
+			expectedProgress: []string{"Relaunch attempt 1 of 1, job at: /api/v2/jobs/2/ is in status: failed"},
+			expectError:      true,
+		},
+		{
+			name:             "relaunched job fails with ignored results",
+			wait:             &waitTrue,
+			ignoreJobResults: &ignoreTrue,
+			setupMock: func(mock *MockProviderHTTPClient) {
+				mockFailedJob(mock)
+				mockJobRelaunch(mock, 1, relaunchHostsFailed, 2)
+				mock.EXPECT().Get("/api/v2/jobs/2/").Return([]byte(`{"status": "failed"}`), diag.Diagnostics{})
+			},
+			expectedProgress: []string{"Relaunch attempt 1 of 1, job at: /api/v2/jobs/2/ is in status: failed"},
+			expectWarning:    true,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockProviderHTTPClient(ctrl)
+			mockClient.EXPECT().getAPIEndpoint().Return("/api/v2")
+			tc.setupMock(mockClient)
+
+			ctx := t.Context()
+			action := &JobRelaunchAction{client: mockClient}
+			schemaResp := fwaction.SchemaResponse{}
+			action.Schema(ctx, fwaction.SchemaRequest{}, &schemaResp)
+			config := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"job_id":                              valueOrNil(tftypes.Number, &jobID),
+				"hosts":                               valueOrNil(tftypes.String, tc.hosts),
+				"wait_for_completion":                 valueOrNil(tftypes.Bool, tc.wait),
+				"wait_for_completion_timeout_seconds": valueOrNil[int64](tftypes.Number, nil),
+				"retries":                             valueOrNil(tftypes.Number, tc.retries),
+				"retry_delay_seconds":                 valueOrNil[int64](tftypes.Number, nil),
+				"ignore_job_results":                  valueOrNil(tftypes.Bool, tc.ignoreJobResults),
+			})
+
+			var progress []string
+			resp := &fwaction.InvokeResponse{
+				SendProgress: func(event fwaction.InvokeProgressEvent) {
+					progress = append(progress, event.Message)
+				},
+			}
+			action.Invoke(ctx, fwaction.InvokeRequest{Config: tfsdk.Config{Raw: config, Schema: schemaResp.Schema}}, resp)
+
+			if tc.expectError != resp.Diagnostics.HasError() {
+				t.Errorf("Expected error (%t), got (%v)", tc.expectError, resp.Diagnostics.Errors())
+			}
+			if tc.expectWarning != (resp.Diagnostics.WarningsCount() > 0) {
+				t.Errorf("Expected warning (%t), got (%v)", tc.expectWarning, resp.Diagnostics.Warnings())
+			}
+			if fmt.Sprint(tc.expectedProgress) != fmt.Sprint(progress) {
+				t.Errorf("Expected progress (%v), got (%v)", tc.expectedProgress, progress)
+			}
+		})
+	}
+}
+
+func TestAccAAPJobRelaunchAction_failed(t *testing.T) {
+	jobTemplateID := os.Getenv("AAP_TEST_JOB_TEMPLATE_FAIL_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccJobResourcePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJobRelaunchAction(jobTemplateID),
+				ExpectError: regexp.MustCompile(".*AAP job failed.*"),
+			},
+		},
+	})
+}
+
+func testAccJobRelaunchAction(jobTemplateID string) string {
+	return fmt.Sprintf(`
+resource "aap_job" "test" {
+	job_template_id     = %s
+	wait_for_completion = true
+	ignore_job_results  = true
+	lifecycle {
+		action_trigger {
+			events = [after_create]
+			actions = [action.aap_job_relaunch.test]
+		}
+	}
+}
+
+action "aap_job_relaunch" "test" {
+	config {
+		job_id              = tonumber(regex("[0-9]+", aap_job.test.url))
+		hosts               = "all"
+		wait_for_completion = true
+		retries             = 1
+	}
+}
+`, jobTemplateID)
+}
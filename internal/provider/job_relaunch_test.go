@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"go.uber.org/mock/gomock"
+)
+
+// mockJobRelaunch mocks the relaunch of the job with the given ID, returning the job with the new ID.
+func mockJobRelaunch(mock *MockProviderHTTPClient, jobID int, hosts string, newJobID int) {
+	mock.EXPECT().doRequest(http.MethodPost, fmt.Sprintf("/api/v2/jobs/%d/relaunch", jobID), nil, gomock.Any()).DoAndReturn(
+		func(_ string, _ string, _ map[string]string, data io.Reader) (*http.Response, []byte, error) {
+			body, _ := io.ReadAll(data)
+			expected := "{}"
+			if hosts != "" {
+				expected = fmt.Sprintf(`{"hosts":%q}`, hosts)
+			}
+			if !bytes.Equal(body, []byte(expected)) {
+				return nil, nil, fmt.Errorf("expected request body %s, got %s", expected, body)
+			}
+			return &http.Response{StatusCode: http.StatusCreated},
+				[]byte(fmt.Sprintf(`{"url": "/api/v2/jobs/%d/", "status": "pending"}`, newJobID)), nil
+		},
+	)
+}
+
+// mockJobHostSummaries mocks the host summaries of the job with the given ID, with a failed host or not.
+func mockJobHostSummaries(mock *MockProviderHTTPClient, jobID int, failed bool) {
+	summary := `{"host_name": "web1", "ok": 2}`
+	if failed {
+		summary = `{"host_name": "web1", "ok": 1, "failures": 1, "failed": true}`
+	}
+	mock.EXPECT().GetWithParams(fmt.Sprintf("/api/v2/jobs/%d/job_host_summaries", jobID), map[string]string{"page": "1", "page_size": "200"}).
+		Return([]byte(fmt.Sprintf(`{"next": null, "results": [%s]}`, summary)), diag.Diagnostics{})
+}
+
+func TestRelaunchAAPJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockProviderHTTPClient(ctrl)
+	mockJobRelaunch(mockClient, 1, relaunchHostsFailed, 2)
+
+	job, diags := RelaunchAAPJob(mockClient, "/api/v2/jobs/1/", relaunchHostsFailed)
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+	if job.URL != "/api/v2/jobs/2/" || job.Status != "pending" {
+		t.Errorf("Unexpected relaunched job %v", job)
+	}
+}
+
+func TestRelaunchHosts(t *testing.T) {
+	var testTable = []struct {
+		name          string
+		status        string
+		hosts         string
+		jobStatus     string
+		hostSummaries bool
+		failedHosts   bool
+		expected      string
+	}{
+		{name: "all hosts", status: "error", hosts: relaunchHostsAll, expected: relaunchHostsAll},
+		{name: "failed hosts", status: "failed", hosts: relaunchHostsFailed, hostSummaries: true, failedHosts: true, expected: relaunchHostsFailed},
+		{name: "no failed hosts", status: "failed", hosts: relaunchHostsFailed, hostSummaries: true, expected: relaunchHostsAll},
+		{name: "job in error", status: "error", hosts: relaunchHostsFailed, expected: relaunchHostsAll},
+		{name: "canceled job", hosts: relaunchHostsFailed, jobStatus: "canceled", expected: relaunchHostsAll},
+		{name: "failed job", hosts: relaunchHostsFailed, jobStatus: "failed", hostSummaries: true, failedHosts: true, expected: relaunchHostsFailed},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockProviderHTTPClient(ctrl)
+			if test.jobStatus != "" {
+				mockClient.EXPECT().Get("/api/v2/jobs/1/").Return([]byte(fmt.Sprintf(`{"status": %q}`, test.jobStatus)), diag.Diagnostics{})
+			}
+			if test.hostSummaries {
+				mockJobHostSummaries(mockClient, 1, test.failedHosts)
+			}
+
+			hosts, diags := RelaunchHosts(t.Context(), mockClient, "/api/v2/jobs/1/", test.status, test.hosts)
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			if hosts != test.expected {
+				t.Errorf("Expected hosts (%s), got (%s)", test.expected, hosts)
+			}
+		})
+	}
+}
+
+func TestRelaunchAAPJobUntilSuccessful(t *testing.T) {
+	var testTable = []struct {
+		name             string
+		status           string
+		attempts         int64
+		statuses         []string
+		expectedURL      string
+		expectedStatus   string
+		expectedProgress []string
+	}{
+		{
+			name:           "already successful",
+			status:         statusSuccessfulConst,
+			attempts:       2,
+			expectedURL:    "/api/v2/jobs/1/",
+			expectedStatus: statusSuccessfulConst,
+		},
+		{
+			name:             "successful on the second attempt",
+			status:           "failed",
+			attempts:         3,
+			statuses:         []string{"failed", statusSuccessfulConst},
+			expectedURL:      "/api/v2/jobs/3/",
+			expectedStatus:   statusSuccessfulConst,
+			expectedProgress: []string{"1 /api/v2/jobs/2/ failed", "2 /api/v2/jobs/3/ successful"},
+		},
+		{
+			name:             "attempts exhausted",
+			status:           "",
+			attempts:         2,
+			statuses:         []string{"error", "failed"},
+			expectedURL:      "/api/v2/jobs/3/",
+			expectedStatus:   "failed",
+			expectedProgress: []string{"1 /api/v2/jobs/2/ error", "2 /api/v2/jobs/3/ failed"},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockProviderHTTPClient(ctrl)
+			for i, status := range test.statuses {
+				mockJobRelaunch(mockClient, i+1, relaunchHostsAll, i+2)
+				mockClient.EXPECT().Get(fmt.Sprintf("/api/v2/jobs/%d/", i+2)).
+					Return([]byte(fmt.Sprintf(`{"status": %q}`, status)), diag.Diagnostics{})
+			}
+
+			var progress []string
+			options := JobRelaunchOptions{Hosts: relaunchHostsAll, Attempts: test.attempts, Timeout: time.Minute}
+			jobURL, status, diags := RelaunchAAPJobUntilSuccessful(t.Context(), mockClient, "/api/v2/jobs/1/", test.status, options,
+				func(attempt int64, jobURL string, status string) {
+					progress = append(progress, fmt.Sprintf("%d %s %s", attempt, jobURL, status))
+				},
+			)
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			if jobURL != test.expectedURL || status != test.expectedStatus {
+				t.Errorf("Expected job (%s, %s), got (%s, %s)", test.expectedURL, test.expectedStatus, jobURL, status)
+			}
+			if !reflect.DeepEqual(test.expectedProgress, progress) {
+				t.Errorf("Expected progress (%v), got (%v)", test.expectedProgress, progress)
+			}
+		})
+	}
+}
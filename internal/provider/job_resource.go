@@ -7,12 +7,16 @@ import (
 	"fmt"
 	"net/http"
 	"path"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -24,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
@@ -32,8 +37,11 @@ const (
 	// Default value for the wait_for_completion timeout, so the linter doesn't complain.
 	waitForCompletionTimeoutDefault int64  = 120
 	statusSuccessfulConst           string = "successful"
+	statusFailedConst               string = "failed"
 	// VerbosityMax is the maximum verbosity level for job runs (WinRM Debug).
 	VerbosityMax int64 = 5
+	// jobFailureStdoutTailLines is the number of lines of the job output included in job failure errors.
+	jobFailureStdoutTailLines int64 = 20
 )
 
 // JobAPIModel represents the AAP API model. /api/controller/v2/jobs/<id>/
@@ -59,23 +67,26 @@ type JobAPIModel struct {
 
 // JobLaunchAPIModel represents the AAP API model for Job Template launch endpoint.
 // GET /api/controller/v2/job_templates/<id>/launch/
-// It helps determine if a job_template can be launched.
+// It helps determine if a job_template can be launched. The Workflow Job Template launch
+// endpoint returns a subset of these fields, the missing ones are never prompted for.
 type JobLaunchAPIModel struct {
-	AskVariablesOnLaunch            bool `json:"ask_variables_on_launch"`
-	AskTagsOnLaunch                 bool `json:"ask_tags_on_launch"`
-	AskSkipTagsOnLaunch             bool `json:"ask_skip_tags_on_launch"`
-	AskJobTypeOnLaunch              bool `json:"ask_job_type_on_launch"`
-	AskLimitOnLaunch                bool `json:"ask_limit_on_launch"`
-	AskInventoryOnLaunch            bool `json:"ask_inventory_on_launch"`
-	AskCredentialOnLaunch           bool `json:"ask_credential_on_launch"`
-	AskExecutionEnvironmentOnLaunch bool `json:"ask_execution_environment_on_launch"`
-	AskLabelsOnLaunch               bool `json:"ask_labels_on_launch"`
-	AskForksOnLaunch                bool `json:"ask_forks_on_launch"`
-	AskDiffModeOnLaunch             bool `json:"ask_diff_mode_on_launch"`
-	AskVerbosityOnLaunch            bool `json:"ask_verbosity_on_launch"`
-	AskInstanceGroupsOnLaunch       bool `json:"ask_instance_groups_on_launch"`
-	AskTimeoutOnLaunch              bool `json:"ask_timeout_on_launch"`
-	AskJobSliceCountOnLaunch        bool `json:"ask_job_slice_count_on_launch"`
+	AskVariablesOnLaunch            bool     `json:"ask_variables_on_launch"`
+	AskTagsOnLaunch                 bool     `json:"ask_tags_on_launch"`
+	AskSkipTagsOnLaunch             bool     `json:"ask_skip_tags_on_launch"`
+	AskJobTypeOnLaunch              bool     `json:"ask_job_type_on_launch"`
+	AskLimitOnLaunch                bool     `json:"ask_limit_on_launch"`
+	AskInventoryOnLaunch            bool     `json:"ask_inventory_on_launch"`
+	AskCredentialOnLaunch           bool     `json:"ask_credential_on_launch"`
+	AskExecutionEnvironmentOnLaunch bool     `json:"ask_execution_environment_on_launch"`
+	AskLabelsOnLaunch               bool     `json:"ask_labels_on_launch"`
+	AskForksOnLaunch                bool     `json:"ask_forks_on_launch"`
+	AskDiffModeOnLaunch             bool     `json:"ask_diff_mode_on_launch"`
+	AskVerbosityOnLaunch            bool     `json:"ask_verbosity_on_launch"`
+	AskInstanceGroupsOnLaunch       bool     `json:"ask_instance_groups_on_launch"`
+	AskTimeoutOnLaunch              bool     `json:"ask_timeout_on_launch"`
+	AskJobSliceCountOnLaunch        bool     `json:"ask_job_slice_count_on_launch"`
+	SurveyEnabled                   bool     `json:"survey_enabled"`
+	VariablesNeededToStart          []string `json:"variables_needed_to_start"`
 }
 
 // JobLaunchRequestModel represents the request body for POST /job_templates/{id}/launch.
@@ -100,23 +111,26 @@ type JobLaunchRequestModel struct {
 
 // JobModel are the attributes that are provided by the user and also used by the action.
 type JobModel struct {
-	TemplateID               types.Int64                      `tfsdk:"job_template_id"`
-	InventoryID              types.Int64                      `tfsdk:"inventory_id"`
-	Credentials              types.List                       `tfsdk:"credentials"`
-	Labels                   types.List                       `tfsdk:"labels"`
-	ExtraVars                customtypes.AAPCustomStringValue `tfsdk:"extra_vars"`
-	WaitForCompletion        types.Bool                       `tfsdk:"wait_for_completion"`
-	WaitForCompletionTimeout types.Int64                      `tfsdk:"wait_for_completion_timeout_seconds"`
-	Limit                    customtypes.AAPCustomStringValue `tfsdk:"limit"`
-	JobTags                  customtypes.AAPCustomStringValue `tfsdk:"job_tags"`
-	SkipTags                 customtypes.AAPCustomStringValue `tfsdk:"skip_tags"`
-	DiffMode                 types.Bool                       `tfsdk:"diff_mode"`
-	Verbosity                types.Int64                      `tfsdk:"verbosity"`
-	ExecutionEnvironmentID   types.Int64                      `tfsdk:"execution_environment"`
-	Forks                    types.Int64                      `tfsdk:"forks"`
-	JobSliceCount            types.Int64                      `tfsdk:"job_slice_count"`
-	Timeout                  types.Int64                      `tfsdk:"timeout"`
-	InstanceGroups           types.List                       `tfsdk:"instance_groups"`
+	TemplateID               types.Int64                         `tfsdk:"job_template_id"`
+	TemplateName             types.String                        `tfsdk:"job_template_name"`
+	OrganizationName         types.String                        `tfsdk:"organization_name"`
+	InventoryID              types.Int64                         `tfsdk:"inventory_id"`
+	Credentials              types.List                          `tfsdk:"credentials"`
+	Labels                   types.List                          `tfsdk:"labels"`
+	ExtraVars                customtypes.AAPCustomVariablesValue `tfsdk:"extra_vars"`
+	WaitForCompletion        types.Bool                          `tfsdk:"wait_for_completion"`
+	WaitForCompletionTimeout types.Int64                         `tfsdk:"wait_for_completion_timeout_seconds"`
+	StreamJobEvents          types.String                        `tfsdk:"stream_job_events"`
+	Limit                    customtypes.AAPCustomStringValue    `tfsdk:"limit"`
+	JobTags                  customtypes.AAPCustomStringValue    `tfsdk:"job_tags"`
+	SkipTags                 customtypes.AAPCustomStringValue    `tfsdk:"skip_tags"`
+	DiffMode                 types.Bool                          `tfsdk:"diff_mode"`
+	Verbosity                types.Int64                         `tfsdk:"verbosity"`
+	ExecutionEnvironmentID   types.Int64                         `tfsdk:"execution_environment"`
+	Forks                    types.Int64                         `tfsdk:"forks"`
+	JobSliceCount            types.Int64                         `tfsdk:"job_slice_count"`
+	Timeout                  types.Int64                         `tfsdk:"timeout"`
+	InstanceGroups           types.List                          `tfsdk:"instance_groups"`
 }
 
 // JobResourceModel maps the resource schema data.
@@ -127,6 +141,44 @@ type JobResourceModel struct {
 	URL           types.String `tfsdk:"url"`
 	IgnoredFields types.List   `tfsdk:"ignored_fields"`
 	Triggers      types.Map    `tfsdk:"triggers"`
+	// IgnoreJobResults only applies to the resource, the action has its own attribute.
+	IgnoreJobResults types.Bool   `tfsdk:"ignore_job_results"`
+	CancelOnDestroy  types.Bool   `tfsdk:"cancel_on_destroy"`
+	CancelOnTimeout  types.Bool   `tfsdk:"cancel_on_timeout"`
+	RelaunchOn       types.Object `tfsdk:"relaunch_on"`
+	JobResultsModel
+}
+
+// JobRelaunchOnModel configures the relaunches of a job that does not succeed.
+type JobRelaunchOnModel struct {
+	Hosts      types.String `tfsdk:"hosts"`
+	Retries    types.Int64  `tfsdk:"retries"`
+	RetryDelay types.Int64  `tfsdk:"retry_delay_seconds"`
+}
+
+// jobRelaunchOnAttrTypes are the attribute types of the relaunch_on attribute.
+var jobRelaunchOnAttrTypes = map[string]attr.Type{
+	"hosts":               types.StringType,
+	"retries":             types.Int64Type,
+	"retry_delay_seconds": types.Int64Type,
+}
+
+// JobResultsModel are the results of a completed job, only retrieved when waiting for completion.
+type JobResultsModel struct {
+	Artifacts     types.String  `tfsdk:"artifacts"`
+	Failed        types.Bool    `tfsdk:"failed"`
+	Started       types.String  `tfsdk:"started"`
+	Finished      types.String  `tfsdk:"finished"`
+	Elapsed       types.Float64 `tfsdk:"elapsed"`
+	HostSummaries types.Object  `tfsdk:"host_summaries"`
+}
+
+// jobResultsHostSummariesAttrTypes are the attribute types of the host_summaries attribute.
+var jobResultsHostSummariesAttrTypes = map[string]attr.Type{
+	"ok":       types.MapType{ElemType: types.Int64Type},
+	"changed":  types.MapType{ElemType: types.Int64Type},
+	"failures": types.MapType{ElemType: types.Int64Type},
+	"dark":     types.MapType{ElemType: types.Int64Type},
 }
 
 // JobResource is the resource implementation.
@@ -136,8 +188,10 @@ type JobResource struct {
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &JobResource{}
-	_ resource.ResourceWithConfigure = &JobResource{}
+	_ resource.Resource                     = &JobResource{}
+	_ resource.ResourceWithConfigure        = &JobResource{}
+	_ resource.ResourceWithModifyPlan       = &JobResource{}
+	_ resource.ResourceWithConfigValidators = &JobResource{}
 )
 
 var keyMapping = map[string]string{
@@ -158,7 +212,7 @@ func IsFinalStateAAPJob(state string) bool {
 		"waiting":             false,
 		"running":             false,
 		statusSuccessfulConst: true,
-		"failed":              true,
+		statusFailedConst:     true,
 		"error":               true,
 		"canceled":            true,
 	}
@@ -166,6 +220,57 @@ func IsFinalStateAAPJob(state string) bool {
 	return isPresent && result
 }
 
+// relaunchUnsuccessfulJobModifier plans a new launch of a job that was waited for and did not succeed.
+type relaunchUnsuccessfulJobModifier struct{}
+
+// RelaunchUnsuccessfulJob returns a plan modifier for the status attribute of the job resources that
+// requires a replacement when the job did not succeed, unless ignore_job_results is set.
+func RelaunchUnsuccessfulJob() planmodifier.String {
+	return relaunchUnsuccessfulJobModifier{}
+}
+
+// Description returns a plain text description of the modifier's behavior.
+func (m relaunchUnsuccessfulJobModifier) Description(_ context.Context) string {
+	return "Launches the job again when it was waited for and did not succeed, unless ignore_job_results is true."
+}
+
+// MarkdownDescription returns a markdown formatted description of the modifier's behavior.
+func (m relaunchUnsuccessfulJobModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString implements the plan modification logic.
+func (m relaunchUnsuccessfulJobModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	status := req.StateValue.ValueString()
+	if !IsFinalStateAAPJob(status) || status == statusSuccessfulConst {
+		return
+	}
+
+	var waitForCompletion, ignoreJobResults types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, tfpath.Root("wait_for_completion"), &waitForCompletion)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, tfpath.Root("ignore_job_results"), &ignoreJobResults)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !waitForCompletion.ValueBool() || ignoreJobResults.ValueBool() {
+		return
+	}
+
+	resp.PlanValue = types.StringUnknown()
+	resp.RequiresReplace = true
+}
+
+// JobCancelAPIModel represents the cancel endpoint of jobs and workflow jobs.
+// /api/controller/v2/jobs/<id>/cancel/
+type JobCancelAPIModel struct {
+	CanCancel bool `json:"can_cancel"`
+}
+
 type RetryProgressFunc func(status string)
 
 func retryUntilAAPJobReachesAnyFinalState(
@@ -203,6 +308,75 @@ func retryUntilAAPJobReachesAnyFinalState(
 	}
 }
 
+// CancelAAPJob cancels the job or workflow job at the given URL and waits until it reaches a final state.
+// A warning is returned when AAP does not allow the job to be canceled.
+func CancelAAPJob(ctx context.Context, client ProviderHTTPClient, jobURL string, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+	cancelURL := path.Join(jobURL, "cancel")
+
+	body, getDiags := client.Get(cancelURL)
+	diags.Append(getDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	var cancel JobCancelAPIModel
+	err := json.Unmarshal(body, &cancel)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+	if !cancel.CanCancel {
+		diags.AddWarning(
+			"AAP job can not be canceled",
+			fmt.Sprintf("AAP does not allow the job at %s to be canceled, it keeps running.", jobURL),
+		)
+		return diags
+	}
+
+	resp, body, err := client.doRequest(http.MethodPost, cancelURL, nil, nil)
+	diags.Append(ValidateResponse(resp, body, err, []int{http.StatusAccepted})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var status string
+	retryProgressFunc := func(status string) {
+		tflog.Debug(ctx, "Job status update", map[string]interface{}{
+			"status": status,
+			"url":    jobURL,
+		})
+	}
+	err = retry.RetryContext(ctx, timeout, retryUntilAAPJobReachesAnyFinalState(ctx, client, retryProgressFunc, jobURL, &status))
+	if err != nil {
+		diags.AddError("error when waiting for AAP job to be canceled", err.Error())
+	}
+	return diags
+}
+
+// CancelRunningAAPJob cancels the job or workflow job at the given URL, unless it was deleted
+// or already reached a final state.
+func CancelRunningAAPJob(ctx context.Context, client ProviderHTTPClient, jobURL string, timeout time.Duration) diag.Diagnostics {
+	body, diags, status := client.GetWithStatus(jobURL, nil)
+	if status == http.StatusNotFound {
+		return diag.Diagnostics{}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	var job JobAPIModel
+	err := json.Unmarshal(body, &job)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+	if IsFinalStateAAPJob(job.Status) {
+		return diags
+	}
+
+	return CancelAAPJob(ctx, client, jobURL, timeout)
+}
+
 // Metadata returns the resource type name.
 func (r *JobResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_job"
@@ -233,8 +407,18 @@ func (r *JobResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"job_template_id": schema.Int64Attribute{
-				Required:    true,
-				Description: "ID of the job template.",
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the job template. Required unless `job_template_name` and `organization_name` are provided.",
+			},
+			"job_template_name": schema.StringAttribute{
+				Optional: true,
+				Description: "Name of the job template, to reference it together with `organization_name` instead of " +
+					"`job_template_id`. The ID of the job template is resolved when the job is launched.",
+			},
+			"organization_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the organization of the job template referenced by `job_template_name`.",
 			},
 			"inventory_id": schema.Int64Attribute{
 				Optional: true,
@@ -254,13 +438,16 @@ func (r *JobResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				Description: "URL of the job template",
 			},
 			"status": schema.StringAttribute{
-				Computed:    true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					RelaunchUnsuccessfulJob(),
+				},
 				Description: "Status of the job",
 			},
 			"extra_vars": schema.StringAttribute{
 				Description: "Extra Variables. Must be provided as either a JSON or YAML string.",
 				Optional:    true,
-				CustomType:  customtypes.AAPCustomStringType{},
+				CustomType:  customtypes.AAPCustomVariablesType{},
 			},
 			"triggers": schema.MapAttribute{
 				Optional:    true,
@@ -388,6 +575,15 @@ func (r *JobResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				Description: "When this is set to `true`, Terraform will wait until this aap_job resource is created, reaches " +
 					"any final status and then, proceeds with the following resource operation",
 			},
+			"stream_job_events": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(jobEventsFilters...),
+				},
+				Description: "When `wait_for_completion` is `true`, logs the output of the job while it runs: " +
+					"`tasks` for the play and task names and the play recap, `failures` for the failed tasks only, " +
+					"or `stdout` for the full output. The output is logged at the INFO level, see `TF_LOG`.",
+			},
 			"wait_for_completion_timeout_seconds": schema.Int64Attribute{
 				Optional: true,
 				Computed: true,
@@ -395,6 +591,104 @@ func (r *JobResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				Description: "Sets the maximum amount of seconds Terraform will wait before timing out the updates, " +
 					"and the job creation will fail. Default value of `120`",
 			},
+			"ignore_job_results": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "When this is set to `true`, and `wait_for_completion` is `true`, a job that does not " +
+					"succeed only raises a warning. Otherwise the apply fails and the job is launched again on the next apply.",
+			},
+			"cancel_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When this is set to `true`, destroying this resource cancels the job if it is still running.",
+			},
+			"cancel_on_timeout": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "When this is set to `true`, and `wait_for_completion` is `true`, the job is canceled " +
+					"when `wait_for_completion_timeout_seconds` expires. Otherwise the job keeps running in AAP.",
+			},
+			"relaunch_on": schema.SingleNestedAttribute{
+				Optional: true,
+				Description: "When `wait_for_completion` is `true`, relaunches the job while it does not succeed, " +
+					"before reporting its results. The `url` and the results are the ones of the last relaunched job.",
+				Attributes: map[string]schema.Attribute{
+					"hosts": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(relaunchHostsValues...),
+						},
+						Description: "The hosts the job is relaunched on: `all` the hosts of the job, or only the hosts " +
+							"that `failed`. Default value of `failed`. A job that did not fail on any host, such as a " +
+							"job in error or canceled, is relaunched on all its hosts.",
+					},
+					"retries": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+						Description: "The maximum number of times the job is relaunched. Default value of `1`.",
+					},
+					"retry_delay_seconds": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+						Description: "The amount of seconds to wait before relaunching the job. Default value of `0`.",
+					},
+				},
+			},
+			"artifacts": schema.StringAttribute{
+				Computed: true,
+				Description: "JSON encoded artifacts of the job, as set by the `set_stats` module. " +
+					"Use `jsondecode` to access the values. Only set when `wait_for_completion` is `true`.",
+			},
+			"failed": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the job failed. Only set when `wait_for_completion` is `true`.",
+			},
+			"started": schema.StringAttribute{
+				Computed:    true,
+				Description: "The date and time the job was queued for starting. Only set when `wait_for_completion` is `true`.",
+			},
+			"finished": schema.StringAttribute{
+				Computed:    true,
+				Description: "The date and time the job finished execution. Only set when `wait_for_completion` is `true`.",
+			},
+			"elapsed": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Elapsed time in seconds that the job ran. Only set when `wait_for_completion` is `true`.",
+			},
+			"host_summaries": schema.SingleNestedAttribute{
+				Computed: true,
+				Description: "Per host task results of the job, each map is keyed by host name. " +
+					"Only set when `wait_for_completion` is `true`.",
+				Attributes: map[string]schema.Attribute{
+					"ok": schema.MapAttribute{
+						Computed:    true,
+						ElementType: types.Int64Type,
+						Description: "Number of tasks that ran successfully on each host.",
+					},
+					"changed": schema.MapAttribute{
+						Computed:    true,
+						ElementType: types.Int64Type,
+						Description: "Number of tasks that changed each host.",
+					},
+					"failures": schema.MapAttribute{
+						Computed:    true,
+						ElementType: types.Int64Type,
+						Description: "Number of tasks that failed on each host.",
+					},
+					"dark": schema.MapAttribute{
+						Computed:    true,
+						ElementType: types.Int64Type,
+						Description: "Number of tasks for which each host was unreachable.",
+					},
+				},
+			},
 		},
 		MarkdownDescription: "Launches an AAP job.\n\n" +
 			"A job is launched only when the resource is first created or when the " +
@@ -402,11 +696,15 @@ func (r *JobResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 			"launch a new job based on any arbitrary value.\n\n" +
 			"This resource always creates a new job in AAP. A destroy will not " +
 			"delete a job created by this resource, it will only remove the resource " +
-			"from the state.\n\n" +
+			"from the state, and cancel the job if it is still running and `cancel_on_destroy` is `true`.\n\n" +
 			"Moreover, you can set `wait_for_completion` to true, then Terraform will " +
 			"wait until this job is created and reaches any final state before continuing. " +
 			"This parameter works in both create and update operations.\n\n" +
-			"You can also tweak `wait_for_completion_timeout_seconds` to control the timeout limit.",
+			"You can also tweak `wait_for_completion_timeout_seconds` to control the timeout limit.\n\n" +
+			"When waiting for completion, the results of the job such as the `artifacts` set by the " +
+			"`set_stats` module and the `host_summaries` are available to other resources. If the job " +
+			"does not succeed, the apply fails and the job is launched again on the next apply, unless " +
+			"`ignore_job_results` is `true`.",
 	}
 }
 
@@ -440,20 +738,42 @@ func (r *JobResource) Create(ctx context.Context, req resource.CreateRequest, re
 	if data.WaitForCompletion.ValueBool() {
 		timeout := time.Duration(data.WaitForCompletionTimeout.ValueInt64()) * time.Second
 		var status string
+		streamer := newJobEventsStreamer(r.client, data.URL.ValueString(), data.StreamJobEvents)
+		logJobEvent := func(message string) {
+			tflog.Info(ctx, message, map[string]interface{}{"url": data.URL.ValueString()})
+		}
 		retryProgressFunc := func(status string) {
 			tflog.Debug(ctx, "Job status update", map[string]interface{}{
 				"status": status,
 				"url":    data.URL.ValueString(),
 			})
+			StreamJobEvents(ctx, streamer, logJobEvent)
 		}
 		err := retry.RetryContext(ctx, timeout, retryUntilAAPJobReachesAnyFinalState(ctx, r.client, retryProgressFunc, data.URL.ValueString(), &status))
+		// Events can still be recorded once the job reached a final state
+		StreamJobEvents(ctx, streamer, logJobEvent)
 		if err != nil {
 			resp.Diagnostics.Append(diag.NewErrorDiagnostic("error when waiting for AAP job to complete", err.Error()))
+			if data.CancelOnTimeout.ValueBool() {
+				resp.Diagnostics.Append(CancelAAPJob(ctx, r.client, data.URL.ValueString(), timeout)...)
+			}
 		}
 		if resp.Diagnostics.HasError() {
 			return
 		}
 		data.Status = types.StringValue(status)
+
+		resp.Diagnostics.Append(data.RelaunchUntilSuccessful(ctx, r.client)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(data.ReadJobResults(ctx, r.client)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		data.JobResultsModel = NewNullJobResults()
 	}
 
 	// Save updated data into Terraform state
@@ -461,6 +781,51 @@ func (r *JobResource) Create(ctx context.Context, req resource.CreateRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// The state is saved before reporting an unsuccessful job, so the next apply launches it again
+	if data.WaitForCompletion.ValueBool() {
+		resp.Diagnostics.Append(data.CheckJobResults(ctx, r.client)...)
+	}
+}
+
+// ConfigValidators returns the configuration validators of the resource.
+func (r *JobResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	// You have either a template id or a template name + organization_name pair
+	return templateConfigValidators("job_template_id", "job_template_name")
+}
+
+// ModifyPlan validates the launch prompts against the job template when a new job is planned,
+// so that missing or ignored prompts are reported before any other resource is changed.
+func (r *JobResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, before the provider is configured or when no job is launched
+	if req.Plan.Raw.IsNull() || r.client == nil || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	// The prompts are read from the plan: omitted prompts, such as inventory_id or limit, are unknown
+	// in the plan and the template defaults are used for them.
+	var data JobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// A template referenced by name is resolved here only to validate the prompts,
+	// its ID is recorded in the state when the job is launched
+	resp.Diagnostics.Append(data.ResolveTemplateID(r.client)...)
+	if resp.Diagnostics.HasError() || !IsValueProvided(data.TemplateID) {
+		return
+	}
+
+	// WriteOnly attributes (credentials, labels) are always null in the plan
+	var configData JobResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Credentials = configData.Credentials
+	data.Labels = configData.Labels
+
+	resp.Diagnostics.Append(data.CanJobBeLaunched(r.client)...)
 }
 
 func (r *JobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -535,20 +900,42 @@ func (r *JobResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	if data.WaitForCompletion.ValueBool() {
 		timeout := time.Duration(data.WaitForCompletionTimeout.ValueInt64()) * time.Second
 		var status string
+		streamer := newJobEventsStreamer(r.client, data.URL.ValueString(), data.StreamJobEvents)
+		logJobEvent := func(message string) {
+			tflog.Info(ctx, message, map[string]interface{}{"url": data.URL.ValueString()})
+		}
 		retryProgressFunc := func(status string) {
 			tflog.Debug(ctx, "Job status update", map[string]interface{}{
 				"status": status,
 				"url":    data.URL.ValueString(),
 			})
+			StreamJobEvents(ctx, streamer, logJobEvent)
 		}
 		err := retry.RetryContext(ctx, timeout, retryUntilAAPJobReachesAnyFinalState(ctx, r.client, retryProgressFunc, data.URL.ValueString(), &status))
+		// Events can still be recorded once the job reached a final state
+		StreamJobEvents(ctx, streamer, logJobEvent)
 		if err != nil {
 			resp.Diagnostics.Append(diag.NewErrorDiagnostic("error when waiting for AAP job to complete", err.Error()))
+			if data.CancelOnTimeout.ValueBool() {
+				resp.Diagnostics.Append(CancelAAPJob(ctx, r.client, data.URL.ValueString(), timeout)...)
+			}
 		}
 		if resp.Diagnostics.HasError() {
 			return
 		}
 		data.Status = types.StringValue(status)
+
+		resp.Diagnostics.Append(data.RelaunchUntilSuccessful(ctx, r.client)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(data.ReadJobResults(ctx, r.client)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		data.JobResultsModel = NewNullJobResults()
 	}
 
 	// Save updated data into Terraform state
@@ -556,11 +943,28 @@ func (r *JobResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// The state is saved before reporting an unsuccessful job, so the next apply launches it again
+	if data.WaitForCompletion.ValueBool() {
+		resp.Diagnostics.Append(data.CheckJobResults(ctx, r.client)...)
+	}
 }
 
-// Delete is intentionally left blank Job and Workflow Job Resources.
-// Current guidance is to manage this inside AAP.
-func (r JobResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+// Delete does not delete the job, current guidance is to manage this inside AAP.
+// The job is canceled if it is still running and cancel_on_destroy is set.
+func (r JobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data JobResourceModel
+
+	// Read current Terraform state data into job resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.CancelOnDestroy.ValueBool() {
+		timeout := time.Duration(data.WaitForCompletionTimeout.ValueInt64()) * time.Second
+		resp.Diagnostics.Append(CancelRunningAAPJob(ctx, r.client, data.URL.ValueString(), timeout)...)
+	}
 }
 
 // CreateRequestBody creates a JSON encoded request body from the job resource data.
@@ -662,11 +1066,32 @@ func (r *JobResourceModel) ParseIgnoredFields(ignoredFields map[string]interface
 	return diags
 }
 
+// ResolveTemplateID sets the ID of the Job Template referenced by job_template_name and organization_name.
+// Nothing is done when the ID is already known or the name is not.
+func (r *JobModel) ResolveTemplateID(client ProviderHTTPClient) diag.Diagnostics {
+	if IsValueProvided(r.TemplateID) || !IsValueProvided(r.TemplateName) || !IsValueProvided(r.OrganizationName) {
+		return nil
+	}
+
+	templateID, diags := ResolveTemplateID(client, path.Join(client.getAPIEndpoint(), "job_templates"),
+		r.TemplateName.ValueString(), r.OrganizationName.ValueString())
+	if diags.HasError() {
+		return diags
+	}
+	r.TemplateID = types.Int64Value(templateID)
+	return diags
+}
+
 // LaunchJob launches a job from the Job Template. It first checks if the job can be launched,
 // then POSTs to launch the job.
 func (r *JobModel) LaunchJob(client ProviderHTTPClient) (body []byte, diags diag.Diagnostics) {
-	// First, check if the job can be launched
-	diags = r.CanJobBeLaunched(client)
+	diags = r.ResolveTemplateID(client)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Then, check if the job can be launched
+	diags.Append(r.CanJobBeLaunched(client)...)
 	if diags.HasError() {
 		return nil, diags
 	}
@@ -721,38 +1146,91 @@ func (r *JobModel) CanJobBeLaunched(client ProviderHTTPClient) (diags diag.Diagn
 		return diags
 	}
 
-	validations := []struct {
-		askOnLaunch bool
-		isNull      bool
-		fieldName   string
-	}{
-		{launchConfig.AskVariablesOnLaunch, r.ExtraVars.IsNull(), "extra_vars"},
-		{launchConfig.AskTagsOnLaunch, r.JobTags.IsNull(), "job_tags"},
-		{launchConfig.AskSkipTagsOnLaunch, r.SkipTags.IsNull(), "skip_tags"},
-		{launchConfig.AskDiffModeOnLaunch, r.DiffMode.IsNull(), "diff_mode"},
-		{launchConfig.AskLimitOnLaunch, r.Limit.IsNull(), "limit"},
-		{launchConfig.AskInventoryOnLaunch, r.InventoryID.IsNull(), "inventory_id"},
-		{launchConfig.AskCredentialOnLaunch, r.Credentials.IsNull(), "credentials"},
-		{launchConfig.AskExecutionEnvironmentOnLaunch, r.ExecutionEnvironmentID.IsNull(), "execution_environment"},
-		{launchConfig.AskLabelsOnLaunch, r.Labels.IsNull(), "labels"},
-		{launchConfig.AskForksOnLaunch, r.Forks.IsNull(), "forks"},
-		{launchConfig.AskVerbosityOnLaunch, r.Verbosity.IsNull(), "verbosity"},
-		{launchConfig.AskInstanceGroupsOnLaunch, r.InstanceGroups.IsNull(), "instance_groups"},
-		{launchConfig.AskTimeoutOnLaunch, r.Timeout.IsNull(), "timeout"},
-		{launchConfig.AskJobSliceCountOnLaunch, r.JobSliceCount.IsNull(), "job_slice_count"},
-	}
-
-	for _, v := range validations {
-		if v.askOnLaunch && v.isNull {
+	// With a survey, extra_vars answers it and is not ignored. It is only required when the variables are
+	// prompted for, the required answers are validated against variables_needed_to_start.
+	var prompts []launchPrompt
+	if launchConfig.AskVariablesOnLaunch || !launchConfig.SurveyEnabled {
+		prompts = append(prompts, launchPrompt{launchConfig.AskVariablesOnLaunch, r.ExtraVars, "extra_vars"})
+	}
+	prompts = append(prompts, []launchPrompt{
+		{launchConfig.AskTagsOnLaunch, r.JobTags, "job_tags"},
+		{launchConfig.AskSkipTagsOnLaunch, r.SkipTags, "skip_tags"},
+		{launchConfig.AskDiffModeOnLaunch, r.DiffMode, "diff_mode"},
+		{launchConfig.AskLimitOnLaunch, r.Limit, "limit"},
+		{launchConfig.AskInventoryOnLaunch, r.InventoryID, "inventory_id"},
+		{launchConfig.AskCredentialOnLaunch, r.Credentials, "credentials"},
+		{launchConfig.AskExecutionEnvironmentOnLaunch, r.ExecutionEnvironmentID, "execution_environment"},
+		{launchConfig.AskLabelsOnLaunch, r.Labels, "labels"},
+		{launchConfig.AskForksOnLaunch, r.Forks, "forks"},
+		{launchConfig.AskVerbosityOnLaunch, r.Verbosity, "verbosity"},
+		{launchConfig.AskInstanceGroupsOnLaunch, r.InstanceGroups, "instance_groups"},
+		{launchConfig.AskTimeoutOnLaunch, r.Timeout, "timeout"},
+		{launchConfig.AskJobSliceCountOnLaunch, r.JobSliceCount, "job_slice_count"},
+	}...)
+	diags.Append(ValidateLaunchPrompts("Job Template", prompts)...)
+	diags.Append(ValidateSurveyVariables("Job Template", r.ExtraVars, launchConfig.VariablesNeededToStart)...)
+	if launchConfig.SurveyEnabled && IsValueProvided(r.ExtraVars) {
+		var surveySpecURL = path.Join(client.getAPIEndpoint(), "job_templates", r.TemplateID.String(), "survey_spec")
+		diags.Append(ValidateSurveySpecAnswers(client, surveySpecURL, r.ExtraVars)...)
+	}
+
+	return diags
+}
+
+// ValidateSurveyVariables returns an error when the extra_vars do not provide the survey variables
+// needed to start the job. Unknown or invalid extra_vars are not validated.
+func ValidateSurveyVariables(templateKind string, extraVars customtypes.AAPCustomVariablesValue, variablesNeededToStart []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(variablesNeededToStart) == 0 || extraVars.IsUnknown() {
+		return diags
+	}
+	variables, err := DecodeVariables(extraVars.ValueString())
+	if err != nil {
+		return diags
+	}
+
+	var missing []string
+	for _, name := range variablesNeededToStart {
+		if _, ok := variables[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		diags.AddAttributeError(
+			tfpath.Root("extra_vars"),
+			"Missing required survey variables",
+			fmt.Sprintf("%s requires the survey variables '%s' to be provided in extra_vars", templateKind, strings.Join(missing, "', '")),
+		)
+	}
+
+	return diags
+}
+
+// launchPrompt is a field that a template may prompt for on launch.
+type launchPrompt struct {
+	askOnLaunch bool
+	value       attr.Value
+	fieldName   string
+}
+
+// ValidateLaunchPrompts returns an error for each field the template prompts for on launch that is missing,
+// and a warning for each provided field that the template does not prompt for, since AAP ignores it.
+// Unknown values are computed by the resources and are neither missing nor ignored.
+func ValidateLaunchPrompts(templateKind string, prompts []launchPrompt) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, p := range prompts {
+		if p.askOnLaunch && p.value.IsNull() {
 			diags.AddError(
 				"Missing required field",
-				fmt.Sprintf("Job Template requires '%s' to be provided at launch", v.fieldName),
+				fmt.Sprintf("%s requires '%s' to be provided at launch", templateKind, p.fieldName),
 			)
 		}
-		if !v.askOnLaunch && !v.isNull {
+		if !p.askOnLaunch && IsValueProvided(p.value) {
 			diags.AddWarning(
 				"Field will be ignored",
-				fmt.Sprintf("'%s' is provided but the Job Template does not allow it to be specified at launch", v.fieldName),
+				fmt.Sprintf("'%s' is provided but the %s does not allow it to be specified at launch", p.fieldName, templateKind),
 			)
 		}
 	}
@@ -760,6 +1238,183 @@ func (r *JobModel) CanJobBeLaunched(client ProviderHTTPClient) (diags diag.Diagn
 	return diags
 }
 
+// NewNullJobResults returns the job results of a job that was not waited for.
+func NewNullJobResults() JobResultsModel {
+	return JobResultsModel{
+		Artifacts:     types.StringNull(),
+		Failed:        types.BoolNull(),
+		Started:       types.StringNull(),
+		Finished:      types.StringNull(),
+		Elapsed:       types.Float64Null(),
+		HostSummaries: types.ObjectNull(jobResultsHostSummariesAttrTypes),
+	}
+}
+
+// ReadJobResults retrieves the results and the host summaries of a completed job.
+func (r *JobResourceModel) ReadJobResults(ctx context.Context, client ProviderHTTPClient) diag.Diagnostics {
+	body, diags := client.Get(r.URL.ValueString())
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(r.ParseJobResults(body)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	hostSummaries, diagsSummaries := GetJobHostSummaries(ctx, client, r.URL.ValueString())
+	diags.Append(diagsSummaries...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(r.ParseHostSummaries(ctx, hostSummaries)...)
+	return diags
+}
+
+// ParseJobResults updates the job results from an AAP API response.
+func (r *JobResourceModel) ParseJobResults(body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var job JobDetailsAPIModel
+	err := json.Unmarshal(body, &job)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	artifacts, diags := ParseJobArtifacts(job.Artifacts)
+	if diags.HasError() {
+		return diags
+	}
+
+	r.Artifacts = artifacts
+	r.Failed = types.BoolValue(job.Failed)
+	r.Started = ParseStringValue(job.Started)
+	r.Finished = ParseStringValue(job.Finished)
+	r.Elapsed = types.Float64Value(job.Elapsed)
+	return diags
+}
+
+// ParseHostSummaries updates the job results with the host summaries of the job.
+func (r *JobResourceModel) ParseHostSummaries(ctx context.Context, hostSummaries []JobHostSummaryAPIModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ok := make(map[string]int64, len(hostSummaries))
+	changed := make(map[string]int64, len(hostSummaries))
+	failures := make(map[string]int64, len(hostSummaries))
+	dark := make(map[string]int64, len(hostSummaries))
+	for _, summary := range hostSummaries {
+		ok[summary.HostName] = summary.Ok
+		changed[summary.HostName] = summary.Changed
+		failures[summary.HostName] = summary.Failures
+		dark[summary.HostName] = summary.Dark
+	}
+
+	values := make(map[string]attr.Value, len(jobResultsHostSummariesAttrTypes))
+	for name, counts := range map[string]map[string]int64{"ok": ok, "changed": changed, "failures": failures, "dark": dark} {
+		value, diagsMap := types.MapValueFrom(ctx, types.Int64Type, counts)
+		diags.Append(diagsMap...)
+		values[name] = value
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	r.HostSummaries, diags = types.ObjectValue(jobResultsHostSummariesAttrTypes, values)
+	return diags
+}
+
+// RelaunchUntilSuccessful relaunches the job as configured by relaunch_on while it does not succeed.
+// The URL and the status are the ones of the last relaunched job.
+func (r *JobResourceModel) RelaunchUntilSuccessful(ctx context.Context, client ProviderHTTPClient) diag.Diagnostics {
+	if !IsValueProvided(r.RelaunchOn) || r.Status.ValueString() == statusSuccessfulConst {
+		return nil
+	}
+	var relaunchOn JobRelaunchOnModel
+	diags := r.RelaunchOn.As(ctx, &relaunchOn, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+
+	options := JobRelaunchOptions{
+		Hosts:    relaunchHostsFailed,
+		Attempts: 1,
+		Delay:    time.Duration(relaunchOn.RetryDelay.ValueInt64()) * time.Second,
+		Timeout:  time.Duration(r.WaitForCompletionTimeout.ValueInt64()) * time.Second,
+	}
+	if IsValueProvided(relaunchOn.Hosts) {
+		options.Hosts = relaunchOn.Hosts.ValueString()
+	}
+	if IsValueProvided(relaunchOn.Retries) {
+		options.Attempts = relaunchOn.Retries.ValueInt64()
+	}
+	progress := func(attempt int64, jobURL string, status string) {
+		tflog.Info(ctx, describeRelaunchAttempt(attempt, options.Attempts, jobURL, status))
+	}
+
+	jobURL, status, relaunchDiags := RelaunchAAPJobUntilSuccessful(ctx, client, r.URL.ValueString(), r.Status.ValueString(), options, progress)
+	diags.Append(relaunchDiags...)
+	r.URL = types.StringValue(jobURL)
+	r.Status = types.StringValue(status)
+	return diags
+}
+
+// CheckJobResults returns an error when the job did not succeed, or a warning if the job results are ignored.
+// The diagnostic details include the job URL, the failed hosts and the last lines of the job output.
+func (r *JobResourceModel) CheckJobResults(ctx context.Context, client ProviderHTTPClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	status := r.Status.ValueString()
+	if status == statusSuccessfulConst {
+		return diags
+	}
+
+	detail := fmt.Sprintf("API Path: %s", r.URL.ValueString())
+	if failedHosts := r.FailedHosts(); len(failedHosts) > 0 {
+		detail += fmt.Sprintf("\nFailed hosts: %s", strings.Join(failedHosts, ", "))
+	}
+	stdout, stdoutDiags := GetJobStdoutTail(client, r.URL.ValueString(), jobFailureStdoutTailLines)
+	if stdoutDiags.HasError() {
+		tflog.Warn(ctx, "Could not retrieve the job output", map[string]any{"url": r.URL.ValueString()})
+	} else if stdout != "" {
+		detail += fmt.Sprintf("\n\nLast lines of the job output:\n%s", stdout)
+	}
+
+	if r.IgnoreJobResults.ValueBool() {
+		diags.AddWarning(fmt.Sprintf("AAP job %s", status), detail)
+	} else {
+		diags.AddError(fmt.Sprintf("AAP job %s", status), detail)
+	}
+	return diags
+}
+
+// FailedHosts returns the sorted names of the hosts with failed or unreachable tasks.
+func (r *JobResultsModel) FailedHosts() []string {
+	if r.HostSummaries.IsNull() || r.HostSummaries.IsUnknown() {
+		return nil
+	}
+
+	failed := map[string]bool{}
+	attributes := r.HostSummaries.Attributes()
+	for _, key := range []string{"failures", "dark"} {
+		counts, ok := attributes[key].(types.Map)
+		if !ok {
+			continue
+		}
+		for host, count := range counts.Elements() {
+			if value, ok := count.(types.Int64); ok && value.ValueInt64() > 0 {
+				failed[host] = true
+			}
+		}
+	}
+
+	hosts := make([]string, 0, len(failed))
+	for host := range failed {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	return hosts
+}
+
 // LaunchJobWithResponse launches a job from the job template and parses the HTTP response
 // into the JobResourceModel fields.
 func (r *JobResourceModel) LaunchJobWithResponse(client ProviderHTTPClient) diag.Diagnostics {
@@ -16,9 +16,14 @@ import (
 	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -101,7 +106,7 @@ func TestJobResourceCreateRequestBody(t *testing.T) {
 		{
 			name: "unknown values",
 			input: JobResourceModel{JobModel: JobModel{
-				ExtraVars:   customtypes.NewAAPCustomStringUnknown(),
+				ExtraVars:   customtypes.NewAAPCustomVariablesUnknown(),
 				InventoryID: basetypes.NewInt64Unknown(),
 				TemplateID:  types.Int64Value(1),
 			}},
@@ -110,7 +115,7 @@ func TestJobResourceCreateRequestBody(t *testing.T) {
 		{
 			name: "null values",
 			input: JobResourceModel{JobModel: JobModel{
-				ExtraVars:   customtypes.NewAAPCustomStringNull(),
+				ExtraVars:   customtypes.NewAAPCustomVariablesNull(),
 				InventoryID: basetypes.NewInt64Null(),
 				TemplateID:  types.Int64Value(1),
 			}},
@@ -119,7 +124,7 @@ func TestJobResourceCreateRequestBody(t *testing.T) {
 		{
 			name: "extra vars only",
 			input: JobResourceModel{JobModel: JobModel{
-				ExtraVars:   customtypes.NewAAPCustomStringValue("{\"test_name\":\"extra_vars\", \"provider\":\"aap\"}"),
+				ExtraVars:   customtypes.NewAAPCustomVariablesValue("{\"test_name\":\"extra_vars\", \"provider\":\"aap\"}"),
 				InventoryID: basetypes.NewInt64Null(),
 			}},
 			expected: []byte(`{"extra_vars":"{\"test_name\":\"extra_vars\", \"provider\":\"aap\"}"}`),
@@ -127,7 +132,7 @@ func TestJobResourceCreateRequestBody(t *testing.T) {
 		{
 			name: "inventory vars only",
 			input: JobResourceModel{JobModel: JobModel{
-				ExtraVars:   customtypes.NewAAPCustomStringNull(),
+				ExtraVars:   customtypes.NewAAPCustomVariablesNull(),
 				InventoryID: basetypes.NewInt64Value(201),
 			}},
 			expected: []byte(`{"inventory": 201}`),
@@ -135,7 +140,7 @@ func TestJobResourceCreateRequestBody(t *testing.T) {
 		{
 			name: "combined",
 			input: JobResourceModel{JobModel: JobModel{
-				ExtraVars:   customtypes.NewAAPCustomStringValue("{\"test_name\":\"extra_vars\", \"provider\":\"aap\"}"),
+				ExtraVars:   customtypes.NewAAPCustomVariablesValue("{\"test_name\":\"extra_vars\", \"provider\":\"aap\"}"),
 				InventoryID: basetypes.NewInt64Value(3),
 			}},
 			expected: []byte(`{"inventory":3,"extra_vars":"{\"test_name\":\"extra_vars\", \"provider\":\"aap\"}"}`),
@@ -193,7 +198,7 @@ func TestJobResourceCreateRequestBody(t *testing.T) {
 func TestJobResourceParseHTTPResponse(t *testing.T) {
 	templateID := basetypes.NewInt64Value(1)
 	inventoryID := basetypes.NewInt64Value(2)
-	extraVars := customtypes.NewAAPCustomStringNull()
+	extraVars := customtypes.NewAAPCustomVariablesNull()
 	// Optional+Computed fields are now set from API response values.
 	// UseStateForUnknown() plan modifiers handle drift prevention at plan time.
 	limit := customtypes.NewAAPCustomStringValue("")
@@ -288,6 +293,697 @@ func TestJobResourceParseHTTPResponse(t *testing.T) {
 	}
 }
 
+func TestJobResourceParseJobResults(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected JobResultsModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: JobResultsModel{},
+			errors:   jsonError,
+		},
+		{
+			name: "completed job",
+			input: []byte(`{"status":"successful","failed":false,"started":"2025-01-01T10:00:00Z",` +
+				`"finished":"2025-01-01T10:00:03Z","elapsed":3.2,"artifacts":{"ip": "10.0.0.1"}}`),
+			expected: JobResultsModel{
+				Artifacts: types.StringValue(`{"ip":"10.0.0.1"}`),
+				Failed:    types.BoolValue(false),
+				Started:   types.StringValue("2025-01-01T10:00:00Z"),
+				Finished:  types.StringValue("2025-01-01T10:00:03Z"),
+				Elapsed:   types.Float64Value(3.2),
+			},
+			errors: diag.Diagnostics{},
+		},
+		{
+			name:  "job without artifacts",
+			input: []byte(`{"status":"failed","failed":true,"started":"2025-01-01T10:00:00Z","finished":"2025-01-01T10:00:03Z","elapsed":3}`),
+			expected: JobResultsModel{
+				Artifacts: types.StringValue("{}"),
+				Failed:    types.BoolValue(true),
+				Started:   types.StringValue("2025-01-01T10:00:00Z"),
+				Finished:  types.StringValue("2025-01-01T10:00:03Z"),
+				Elapsed:   types.Float64Value(3),
+			},
+			errors: diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := JobResourceModel{}
+			diags := resource.ParseJobResults(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if !reflect.DeepEqual(test.expected, resource.JobResultsModel) {
+				t.Errorf("Expected (%v) not equal to actual (%v)", test.expected, resource.JobResultsModel)
+			}
+		})
+	}
+}
+
+func TestJobResourceReadJobResults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockProviderHTTPClient(ctrl)
+	mockClient.EXPECT().Get("/api/v2/jobs/1/").Return(
+		[]byte(`{"status":"successful","failed":false,"elapsed":1.5,"artifacts":{}}`),
+		diag.Diagnostics{},
+	)
+	mockClient.EXPECT().GetWithParams("/api/v2/jobs/1/job_host_summaries", map[string]string{"page": "1", "page_size": "200"}).Return(
+		[]byte(`{"next":null,"results":[{"host_name":"web1","ok":4,"changed":2},{"host_name":"web2","ok":1,"failures":1,"dark":1}]}`),
+		diag.Diagnostics{},
+	)
+
+	model := JobResourceModel{URL: types.StringValue("/api/v2/jobs/1/")}
+	diags := model.ReadJobResults(t.Context(), mockClient)
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+
+	expected, diags := types.ObjectValue(jobResultsHostSummariesAttrTypes, map[string]attr.Value{
+		"ok": types.MapValueMust(types.Int64Type, map[string]attr.Value{
+			"web1": types.Int64Value(4), "web2": types.Int64Value(1),
+		}),
+		"changed": types.MapValueMust(types.Int64Type, map[string]attr.Value{
+			"web1": types.Int64Value(2), "web2": types.Int64Value(0),
+		}),
+		"failures": types.MapValueMust(types.Int64Type, map[string]attr.Value{
+			"web1": types.Int64Value(0), "web2": types.Int64Value(1),
+		}),
+		"dark": types.MapValueMust(types.Int64Type, map[string]attr.Value{
+			"web1": types.Int64Value(0), "web2": types.Int64Value(1),
+		}),
+	})
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+	if !expected.Equal(model.HostSummaries) {
+		t.Errorf("Expected (%s) not equal to actual (%s)", expected, model.HostSummaries)
+	}
+	if model.Elapsed.ValueFloat64() != 1.5 || model.Artifacts.ValueString() != "{}" {
+		t.Errorf("Unexpected job results: %v", model.JobResultsModel)
+	}
+}
+
+func TestNewNullJobResults(t *testing.T) {
+	results := NewNullJobResults()
+	values := []attr.Value{results.Artifacts, results.Failed, results.Started, results.Finished, results.Elapsed, results.HostSummaries}
+	for _, value := range values {
+		if !value.IsNull() {
+			t.Errorf("Expected null job result, got %s", value)
+		}
+	}
+}
+
+func TestJobResourceRelaunchUntilSuccessful(t *testing.T) {
+	relaunchOn := func(hosts attr.Value) types.Object {
+		return types.ObjectValueMust(jobRelaunchOnAttrTypes, map[string]attr.Value{
+			"hosts":               hosts,
+			"retries":             types.Int64Null(),
+			"retry_delay_seconds": types.Int64Value(0),
+		})
+	}
+
+	var testTable = []struct {
+		name           string
+		status         string
+		relaunchOn     types.Object
+		failedHosts    bool
+		relaunchHosts  string
+		expectedURL    string
+		expectedStatus string
+	}{
+		{name: "no relaunch", status: "failed", relaunchOn: types.ObjectNull(jobRelaunchOnAttrTypes), expectedURL: "/api/v2/jobs/1/", expectedStatus: "failed"},
+		{name: "successful job", status: statusSuccessfulConst, relaunchOn: relaunchOn(types.StringNull()), expectedURL: "/api/v2/jobs/1/", expectedStatus: statusSuccessfulConst},
+		{
+			name: "failed hosts by default", status: "failed", relaunchOn: relaunchOn(types.StringNull()), failedHosts: true,
+			relaunchHosts: relaunchHostsFailed, expectedURL: "/api/v2/jobs/2/", expectedStatus: statusSuccessfulConst,
+		},
+		{
+			name: "all hosts by default without failed hosts", status: "error", relaunchOn: relaunchOn(types.StringNull()),
+			relaunchHosts: relaunchHostsAll, expectedURL: "/api/v2/jobs/2/", expectedStatus: statusSuccessfulConst,
+		},
+		{
+			name: "all hosts", status: "error", relaunchOn: relaunchOn(types.StringValue(relaunchHostsAll)),
+			relaunchHosts: relaunchHostsAll, expectedURL: "/api/v2/jobs/2/", expectedStatus: statusSuccessfulConst,
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockProviderHTTPClient(ctrl)
+			if test.failedHosts {
+				mockJobHostSummaries(mockClient, 1, true)
+			}
+			if test.relaunchHosts != "" {
+				mockJobRelaunch(mockClient, 1, test.relaunchHosts, 2)
+				mockClient.EXPECT().Get("/api/v2/jobs/2/").Return([]byte(`{"status": "successful"}`), diag.Diagnostics{})
+			}
+
+			data := JobResourceModel{
+				JobModel:   JobModel{WaitForCompletionTimeout: types.Int64Value(60)},
+				URL:        types.StringValue("/api/v2/jobs/1/"),
+				Status:     types.StringValue(test.status),
+				RelaunchOn: test.relaunchOn,
+			}
+			diags := data.RelaunchUntilSuccessful(t.Context(), mockClient)
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			if data.URL.ValueString() != test.expectedURL || data.Status.ValueString() != test.expectedStatus {
+				t.Errorf("Expected job (%s, %s), got (%s, %s)", test.expectedURL, test.expectedStatus, data.URL, data.Status)
+			}
+		})
+	}
+}
+
+func TestJobResourceCheckJobResults(t *testing.T) {
+	jobURL := "/api/v2/jobs/1/"
+	hostSummaries := types.ObjectValueMust(jobResultsHostSummariesAttrTypes, map[string]attr.Value{
+		"ok":      types.MapValueMust(types.Int64Type, map[string]attr.Value{"web1": types.Int64Value(2), "web2": types.Int64Value(1)}),
+		"changed": types.MapValueMust(types.Int64Type, map[string]attr.Value{"web1": types.Int64Value(0), "web2": types.Int64Value(0)}),
+		"failures": types.MapValueMust(types.Int64Type, map[string]attr.Value{
+			"web1": types.Int64Value(0), "web2": types.Int64Value(1), "web3": types.Int64Value(0),
+		}),
+		"dark": types.MapValueMust(types.Int64Type, map[string]attr.Value{
+			"web1": types.Int64Value(0), "web2": types.Int64Value(0), "web3": types.Int64Value(1),
+		}),
+	})
+	stdoutErrors := diag.Diagnostics{}
+	stdoutErrors.AddError("Client request error", "stdout unavailable")
+
+	var testTable = []struct {
+		name          string
+		status        string
+		ignore        bool
+		stdout        []byte
+		stdoutDiags   diag.Diagnostics
+		expectStdout  bool
+		expectedDiags func() diag.Diagnostics
+	}{
+		{
+			name:          "successful job",
+			status:        statusSuccessfulConst,
+			expectedDiags: func() diag.Diagnostics { return diag.Diagnostics{} },
+		},
+		{
+			name:         "failed job",
+			status:       "failed",
+			stdout:       []byte("TASK [fail]\nfatal: [web2]: FAILED!\nPLAY RECAP\n"),
+			expectStdout: true,
+			expectedDiags: func() diag.Diagnostics {
+				diags := diag.Diagnostics{}
+				diags.AddError("AAP job failed", "API Path: /api/v2/jobs/1/\nFailed hosts: web2, web3\n\n"+
+					"Last lines of the job output:\nTASK [fail]\nfatal: [web2]: FAILED!\nPLAY RECAP")
+				return diags
+			},
+		},
+		{
+			name:         "failed job with ignored results",
+			status:       "failed",
+			ignore:       true,
+			stdout:       []byte("PLAY RECAP\n"),
+			expectStdout: true,
+			expectedDiags: func() diag.Diagnostics {
+				diags := diag.Diagnostics{}
+				diags.AddWarning("AAP job failed", "API Path: /api/v2/jobs/1/\nFailed hosts: web2, web3\n\nLast lines of the job output:\nPLAY RECAP")
+				return diags
+			},
+		},
+		{
+			name:         "canceled job without output",
+			status:       "canceled",
+			stdoutDiags:  stdoutErrors,
+			expectStdout: true,
+			expectedDiags: func() diag.Diagnostics {
+				diags := diag.Diagnostics{}
+				diags.AddError("AAP job canceled", "API Path: /api/v2/jobs/1/\nFailed hosts: web2, web3")
+				return diags
+			},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockProviderHTTPClient(ctrl)
+			if test.expectStdout {
+				mockClient.EXPECT().
+					GetWithParams("/api/v2/jobs/1/stdout", map[string]string{"format": "txt"}).
+					Return(test.stdout, test.stdoutDiags)
+			}
+
+			model := JobResourceModel{
+				URL:              types.StringValue(jobURL),
+				Status:           types.StringValue(test.status),
+				IgnoreJobResults: types.BoolValue(test.ignore),
+				JobResultsModel:  JobResultsModel{HostSummaries: hostSummaries},
+			}
+			diags := model.CheckJobResults(t.Context(), mockClient)
+			if !test.expectedDiags().Equal(diags) {
+				t.Errorf("Expected diagnostics (%s), actual was (%s)", test.expectedDiags(), diags)
+			}
+		})
+	}
+}
+
+func TestJobResultsFailedHosts(t *testing.T) {
+	results := NewNullJobResults()
+	if hosts := results.FailedHosts(); len(hosts) != 0 {
+		t.Errorf("Expected no failed hosts for null host summaries, got %v", hosts)
+	}
+}
+
+func TestCancelAAPJob(t *testing.T) {
+	jobURL := "/api/v2/jobs/1/"
+
+	t.Run("cancels the job and waits for it", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := NewMockProviderHTTPClient(ctrl)
+		gomock.InOrder(
+			mockClient.EXPECT().Get("/api/v2/jobs/1/cancel").Return([]byte(`{"can_cancel":true}`), diag.Diagnostics{}),
+			mockClient.EXPECT().doRequest(http.MethodPost, "/api/v2/jobs/1/cancel", nil, nil).
+				Return(&http.Response{StatusCode: http.StatusAccepted}, []byte{}, nil),
+			mockClient.EXPECT().Get(jobURL).Return([]byte(`{"status":"canceled"}`), diag.Diagnostics{}),
+		)
+
+		diags := CancelAAPJob(t.Context(), mockClient, jobURL, time.Minute)
+		if diags.HasError() || diags.WarningsCount() > 0 {
+			t.Errorf("Unexpected diagnostics: %s", diags)
+		}
+	})
+
+	t.Run("warns when the job can not be canceled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := NewMockProviderHTTPClient(ctrl)
+		mockClient.EXPECT().Get("/api/v2/jobs/1/cancel").Return([]byte(`{"can_cancel":false}`), diag.Diagnostics{})
+
+		diags := CancelAAPJob(t.Context(), mockClient, jobURL, time.Minute)
+		if diags.HasError() || diags.WarningsCount() != 1 {
+			t.Fatalf("Expected a single warning, got: %s", diags)
+		}
+		if diags.Warnings()[0].Summary() != "AAP job can not be canceled" {
+			t.Errorf("Unexpected warning summary: %s", diags.Warnings()[0].Summary())
+		}
+	})
+}
+
+func TestCancelRunningAAPJob(t *testing.T) {
+	jobURL := "/api/v2/jobs/1/"
+
+	var testTable = []struct {
+		name         string
+		body         []byte
+		statusCode   int
+		expectCancel bool
+	}{
+		{name: "deleted job", body: []byte(`{"detail":"Not found."}`), statusCode: http.StatusNotFound},
+		{name: "finished job", body: []byte(`{"status":"successful"}`), statusCode: http.StatusOK},
+		{name: "running job", body: []byte(`{"status":"running"}`), statusCode: http.StatusOK, expectCancel: true},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			getDiags := diag.Diagnostics{}
+			if test.statusCode != http.StatusOK {
+				getDiags.AddError("Unexpected HTTP status code received", "Not found")
+			}
+			mockClient := NewMockProviderHTTPClient(ctrl)
+			mockClient.EXPECT().GetWithStatus(jobURL, nil).Return(test.body, getDiags, test.statusCode)
+			if test.expectCancel {
+				mockClient.EXPECT().Get("/api/v2/jobs/1/cancel").Return([]byte(`{"can_cancel":false}`), diag.Diagnostics{})
+			}
+
+			diags := CancelRunningAAPJob(t.Context(), mockClient, jobURL, time.Minute)
+			if diags.HasError() {
+				t.Errorf("Unexpected errors: %s", diags)
+			}
+			if test.expectCancel != (diags.WarningsCount() == 1) {
+				t.Errorf("Unexpected diagnostics: %s", diags)
+			}
+		})
+	}
+}
+
+func TestJobResourceConfigValidators(t *testing.T) {
+	ctx := t.Context()
+	schemaResponse := &fwresource.SchemaResponse{}
+	NewJobResource().Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+	jobSchema := schemaResponse.Schema
+
+	var testTable = []struct {
+		name             string
+		templateID       types.Int64
+		templateName     types.String
+		organizationName types.String
+		expectError      bool
+	}{
+		{name: "template id", templateID: types.Int64Value(1), templateName: types.StringNull(), organizationName: types.StringNull()},
+		{name: "template name", templateID: types.Int64Null(), templateName: types.StringValue("Deploy app"), organizationName: types.StringValue("Default")},
+		{name: "no template", templateID: types.Int64Null(), templateName: types.StringNull(), organizationName: types.StringNull(), expectError: true},
+		{name: "template id and name", templateID: types.Int64Value(1), templateName: types.StringValue("Deploy app"), organizationName: types.StringValue("Default"), expectError: true},
+		{name: "template name without organization", templateID: types.Int64Null(), templateName: types.StringValue("Deploy app"), organizationName: types.StringNull(), expectError: true},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			model := JobResourceModel{
+				JobModel: JobModel{
+					TemplateID:       test.templateID,
+					TemplateName:     test.templateName,
+					OrganizationName: test.organizationName,
+					ExtraVars:        customtypes.NewAAPCustomVariablesNull(),
+					InstanceGroups:   types.ListNull(types.Int64Type),
+					Credentials:      types.ListNull(types.Int64Type),
+					Labels:           types.ListNull(types.Int64Type),
+				},
+				IgnoredFields:   types.ListNull(types.StringType),
+				Triggers:        types.MapNull(types.StringType),
+				RelaunchOn:      types.ObjectNull(jobRelaunchOnAttrTypes),
+				JobResultsModel: NewNullJobResults(),
+			}
+			config := tfsdk.Plan{Schema: jobSchema, Raw: tftypes.NewValue(jobSchema.Type().TerraformType(ctx), nil)}
+			if diags := config.Set(ctx, &model); diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+
+			var diags diag.Diagnostics
+			for _, validator := range NewJobResource().(*JobResource).ConfigValidators(ctx) {
+				resp := &fwresource.ValidateConfigResponse{}
+				validator.ValidateResource(ctx, fwresource.ValidateConfigRequest{
+					Config: tfsdk.Config{Schema: jobSchema, Raw: config.Raw},
+				}, resp)
+				diags.Append(resp.Diagnostics...)
+			}
+			if test.expectError != diags.HasError() {
+				t.Errorf("Expected error (%t), got (%v)", test.expectError, diags.Errors())
+			}
+		})
+	}
+}
+
+func TestJobResourceModifyPlan(t *testing.T) {
+	ctx := t.Context()
+	schemaResponse := &fwresource.SchemaResponse{}
+	NewJobResource().Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+	jobSchema := schemaResponse.Schema
+
+	newModel := func(templateID types.Int64, extraVars string) JobResourceModel {
+		return JobResourceModel{
+			JobModel: JobModel{
+				TemplateID:     templateID,
+				ExtraVars:      customtypes.NewAAPCustomVariablesValue(extraVars),
+				InstanceGroups: types.ListNull(types.Int64Type),
+				Credentials:    types.ListNull(types.Int64Type),
+				Labels:         types.ListNull(types.Int64Type),
+			},
+			IgnoredFields:   types.ListNull(types.StringType),
+			Triggers:        types.MapNull(types.StringType),
+			RelaunchOn:      types.ObjectNull(jobRelaunchOnAttrTypes),
+			JobResultsModel: NewNullJobResults(),
+		}
+	}
+	newPlan := func(t *testing.T, model *JobResourceModel) tfsdk.Plan {
+		plan := tfsdk.Plan{Schema: jobSchema, Raw: tftypes.NewValue(jobSchema.Type().TerraformType(ctx), nil)}
+		if model != nil {
+			if diags := plan.Set(ctx, model); diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+		}
+		return plan
+	}
+
+	launched := newModel(types.Int64Value(1), `{"region": "eu"}`)
+	launched.URL = types.StringValue("/api/v2/jobs/2/")
+	launched.Status = types.StringValue(statusSuccessfulConst)
+	unknownTemplate := newModel(types.Int64Unknown(), `{"region": "eu"}`)
+	omittedPrompts := newModel(types.Int64Value(1), `{"region": "eu"}`)
+	omittedPromptsPlan := omittedPrompts
+	omittedPromptsPlan.InventoryID = types.Int64Unknown()
+	omittedPromptsPlan.Limit = customtypes.NewAAPCustomStringUnknown()
+	invalidAnswers := newModel(types.Int64Value(1), `{"env": "prod"}`)
+	byName := newModel(types.Int64Unknown(), `{"region": "eu"}`)
+	byName.TemplateName = types.StringValue("Deploy app")
+	byName.OrganizationName = types.StringValue("Default")
+
+	var testTable = []struct {
+		name           string
+		state          *JobResourceModel
+		plan           *JobResourceModel
+		config         *JobResourceModel
+		launchConfig   string
+		surveySpec     string
+		expectedErrors []string
+	}{
+		{name: "destroy", state: &launched},
+		{name: "no new job", state: &launched, plan: &launched},
+		{name: "unknown template", plan: &unknownTemplate},
+		{
+			name:         "valid prompts",
+			plan:         &launched,
+			launchConfig: `{"ask_variables_on_launch": true, "variables_needed_to_start": ["region"]}`,
+		},
+		{
+			name:         "template name",
+			plan:         &byName,
+			launchConfig: `{"ask_variables_on_launch": true}`,
+		},
+		{
+			name:         "omitted prompts use the template defaults",
+			plan:         &omittedPromptsPlan,
+			config:       &omittedPrompts,
+			launchConfig: `{"ask_inventory_on_launch": true, "ask_limit_on_launch": true}`,
+		},
+		{
+			name:           "missing credentials",
+			plan:           &omittedPromptsPlan,
+			config:         &omittedPrompts,
+			launchConfig:   `{"ask_credential_on_launch": true}`,
+			expectedErrors: []string{"Missing required field"},
+		},
+		{
+			name:           "invalid survey answers",
+			plan:           &invalidAnswers,
+			launchConfig:   `{"survey_enabled": true, "variables_needed_to_start": ["region", "size"]}`,
+			surveySpec:     `{"spec": [{"question_name": "Environment", "variable": "env", "type": "multiplechoice", "choices": ["dev", "test"]}]}`,
+			expectedErrors: []string{"Missing required survey variables", "Invalid survey answer"},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockProviderHTTPClient(ctrl)
+			if test.launchConfig != "" {
+				mockClient.EXPECT().getAPIEndpoint().Return("/api/v2").AnyTimes()
+				mockClient.EXPECT().doRequest(http.MethodGet, "/api/v2/job_templates/1/launch", nil, nil).
+					Return(&http.Response{StatusCode: http.StatusOK}, []byte(test.launchConfig), nil)
+			}
+			if test.plan != nil && IsValueProvided(test.plan.TemplateName) {
+				mockClient.EXPECT().Get("/api/v2/job_templates/Deploy app++Default").Return([]byte(`{"id": 1}`), diag.Diagnostics{})
+			}
+			if test.surveySpec != "" {
+				mockClient.EXPECT().Get("/api/v2/job_templates/1/survey_spec").Return([]byte(test.surveySpec), diag.Diagnostics{})
+			}
+
+			plan := newPlan(t, test.plan)
+			state := newPlan(t, test.state)
+			config := plan
+			if test.config != nil {
+				config = newPlan(t, test.config)
+			}
+			req := fwresource.ModifyPlanRequest{
+				Plan:   plan,
+				State:  tfsdk.State{Schema: jobSchema, Raw: state.Raw},
+				Config: tfsdk.Config{Schema: jobSchema, Raw: config.Raw},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+
+			r := JobResource{client: mockClient}
+			r.ModifyPlan(ctx, req, resp)
+
+			var errors []string
+			for _, d := range resp.Diagnostics.Errors() {
+				errors = append(errors, d.Summary())
+			}
+			if !reflect.DeepEqual(test.expectedErrors, errors) {
+				t.Errorf("Expected errors (%v), got (%v)", test.expectedErrors, resp.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestValidateSurveyVariables(t *testing.T) {
+	var testTable = []struct {
+		name      string
+		extraVars customtypes.AAPCustomVariablesValue
+		needed    []string
+		expected  string
+	}{
+		{name: "no survey", extraVars: customtypes.NewAAPCustomVariablesNull(), needed: nil},
+		{name: "unknown extra vars", extraVars: customtypes.NewAAPCustomVariablesUnknown(), needed: []string{"region"}},
+		{name: "JSON extra vars", extraVars: customtypes.NewAAPCustomVariablesValue(`{"region": "eu"}`), needed: []string{"region"}},
+		{name: "YAML extra vars", extraVars: customtypes.NewAAPCustomVariablesValue("region: eu\nsize: 3\n"), needed: []string{"region", "size"}},
+		{
+			name:      "missing variables",
+			extraVars: customtypes.NewAAPCustomVariablesValue("region: eu"),
+			needed:    []string{"region", "size", "zone"},
+			expected:  "Job Template requires the survey variables 'size', 'zone' to be provided in extra_vars",
+		},
+		{
+			name:      "null extra vars",
+			extraVars: customtypes.NewAAPCustomVariablesNull(),
+			needed:    []string{"region"},
+			expected:  "Job Template requires the survey variables 'region' to be provided in extra_vars",
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			diags := ValidateSurveyVariables("Job Template", test.extraVars, test.needed)
+			if test.expected == "" {
+				if diags.HasError() {
+					t.Errorf("Unexpected errors: %v", diags.Errors())
+				}
+				return
+			}
+			if diags.ErrorsCount() != 1 || diags.Errors()[0].Detail() != test.expected {
+				t.Errorf("Expected error (%s), got (%v)", test.expected, diags.Errors())
+			}
+		})
+	}
+}
+
+func TestRelaunchUnsuccessfulJob(t *testing.T) {
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"status":              schema.StringAttribute{Computed: true},
+			"wait_for_completion": schema.BoolAttribute{Optional: true},
+			"ignore_job_results":  schema.BoolAttribute{Optional: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"status":              tftypes.String,
+		"wait_for_completion": tftypes.Bool,
+		"ignore_job_results":  tftypes.Bool,
+	}}
+	newRaw := func(status string, wait bool, ignore bool) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"status":              tftypes.NewValue(tftypes.String, status),
+			"wait_for_completion": tftypes.NewValue(tftypes.Bool, wait),
+			"ignore_job_results":  tftypes.NewValue(tftypes.Bool, ignore),
+		})
+	}
+
+	var testTable = []struct {
+		name            string
+		state           tftypes.Value
+		plan            tftypes.Value
+		requiresReplace bool
+	}{
+		{
+			name:  "create",
+			state: tftypes.NewValue(objectType, nil),
+			plan:  newRaw("", true, false),
+		},
+		{
+			name:  "successful job",
+			state: newRaw(statusSuccessfulConst, true, false),
+			plan:  newRaw(statusSuccessfulConst, true, false),
+		},
+		{
+			name:  "running job",
+			state: newRaw(statusRunningConst, true, false),
+			plan:  newRaw(statusRunningConst, true, false),
+		},
+		{
+			name:  "failed job not waited for",
+			state: newRaw("failed", false, false),
+			plan:  newRaw("failed", false, false),
+		},
+		{
+			name:  "failed job with ignored results",
+			state: newRaw("failed", true, true),
+			plan:  newRaw("failed", true, true),
+		},
+		{
+			name:            "failed job",
+			state:           newRaw("failed", true, false),
+			plan:            newRaw("failed", true, false),
+			requiresReplace: true,
+		},
+		{
+			name:            "errored job",
+			state:           newRaw("error", true, false),
+			plan:            newRaw("error", true, false),
+			requiresReplace: true,
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := t.Context()
+			state := tfsdk.State{Schema: testSchema, Raw: test.state}
+			plan := tfsdk.Plan{Schema: testSchema, Raw: test.plan}
+
+			var stateValue, planValue types.String
+			if !test.state.IsNull() {
+				state.GetAttribute(ctx, tfpath.Root("status"), &stateValue)
+			} else {
+				stateValue = types.StringNull()
+			}
+			plan.GetAttribute(ctx, tfpath.Root("status"), &planValue)
+
+			req := planmodifier.StringRequest{
+				Path:       tfpath.Root("status"),
+				State:      state,
+				Plan:       plan,
+				StateValue: stateValue,
+				PlanValue:  planValue,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: planValue}
+			RelaunchUnsuccessfulJob().PlanModifyString(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics.Errors())
+			}
+			if resp.RequiresReplace != test.requiresReplace {
+				t.Errorf("Expected RequiresReplace %t, got %t", test.requiresReplace, resp.RequiresReplace)
+			}
+			if test.requiresReplace && !resp.PlanValue.IsUnknown() {
+				t.Errorf("Expected unknown status in plan, got %s", resp.PlanValue)
+			}
+			if !test.requiresReplace && !resp.PlanValue.Equal(planValue) {
+				t.Errorf("Expected unchanged status in plan, got %s", resp.PlanValue)
+			}
+		})
+	}
+}
+
 // Acceptance tests
 
 func getJobResourceFromStateFile(s *terraform.State) (map[string]interface{}, error) {
@@ -370,12 +1066,40 @@ func TestAccAAPJob_basic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					checkBasicJobAttributes(t, resourceNameJob, reJobStatus),
 					testAccCheckJobExists,
+					// Job results are only retrieved when waiting for completion
+					resource.TestCheckNoResourceAttr(resourceNameJob, "artifacts"),
+					resource.TestCheckNoResourceAttr(resourceNameJob, "host_summaries.%"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccAAPJob_TemplateName(t *testing.T) {
+	jobTemplateID := os.Getenv("AAP_TEST_JOB_TEMPLATE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccJobResourcePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobWithTemplateName(jobTemplateID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkBasicJobAttributes(t, resourceNameJob, reJobStatus),
+					// The job template ID is resolved from its name
+					resource.TestCheckResourceAttr(resourceNameJob, "job_template_id", jobTemplateID),
+					testAccCheckJobExists,
+				),
+			},
+			// The job is not launched again while the name resolves to the same job template
+			{
+				Config:   testAccJobWithTemplateName(jobTemplateID),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccAAPJob_UpdateWithSameParameters(t *testing.T) {
 	var jobURLBefore string
 
@@ -496,12 +1220,105 @@ func TestAccAAPJob_WaitForCompletion(t *testing.T) {
 					// Verify wait_for_completion was actually used
 					resource.TestCheckResourceAttr("aap_job.test", "wait_for_completion", "true"),
 					resource.TestCheckResourceAttr("aap_job.test", "wait_for_completion_timeout_seconds", "300"),
+					resource.TestCheckResourceAttr("aap_job.test", "stream_job_events", "tasks"),
+					// Verify the job results were retrieved
+					resource.TestCheckResourceAttr("aap_job.test", "failed", "false"),
+					resource.TestCheckResourceAttr("aap_job.test", "artifacts", "{}"),
+					resource.TestCheckResourceAttrSet("aap_job.test", "started"),
+					resource.TestCheckResourceAttrSet("aap_job.test", "finished"),
+					resource.TestCheckResourceAttrSet("aap_job.test", "elapsed"),
+					resource.TestCheckResourceAttrSet("aap_job.test", "host_summaries.ok.localhost"),
+					resource.TestCheckResourceAttr("aap_job.test", "host_summaries.failures.localhost", "0"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccAAPJob_WaitForCompletionWithFailure(t *testing.T) {
+	jobTemplateID := os.Getenv("AAP_TEST_JOB_TEMPLATE_FAIL_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccJobResourcePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// An unsuccessful job fails the apply by default
+			{
+				Config:      testAccJobIgnoreJobResults(jobTemplateID, false),
+				ExpectError: regexp.MustCompile("AAP job failed"),
+			},
+			// The tainted job is launched again, and its failure is ignored
+			{
+				Config: testAccJobIgnoreJobResults(jobTemplateID, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists,
+					resource.TestCheckResourceAttr(resourceNameJob, "ignore_job_results", "true"),
+					resource.TestCheckResourceAttr(resourceNameJob, "status", "failed"),
+					resource.TestCheckResourceAttr(resourceNameJob, "failed", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAAPJob_CancelOnTimeout(t *testing.T) {
+	jobTemplateID := os.Getenv("AAP_TEST_JOB_FOR_HOST_RETRY_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccJobResourcePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJobCancelOnTimeout(jobTemplateID),
+				ExpectError: regexp.MustCompile("error when waiting for AAP job to complete"),
+			},
+		},
+	})
+}
+
+func TestAccAAPJob_CancelOnDestroy(t *testing.T) {
+	jobTemplateID := os.Getenv("AAP_TEST_JOB_FOR_HOST_RETRY_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccJobResourcePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobCancelOnDestroy(jobTemplateID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists,
+					resource.TestCheckResourceAttr(resourceNameJob, "cancel_on_destroy", "true"),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckJobCanceled,
+	})
+}
+
+// testAccCheckJobCanceled verifies the jobs have been canceled on destroy.
+func testAccCheckJobCanceled(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aap_job" {
+			continue
+		}
+
+		body, err := testGetResource(rs.Primary.Attributes["url"])
+		if err != nil {
+			return err
+		}
+		var job JobAPIModel
+		err = json.Unmarshal(body, &job)
+		if err != nil {
+			return err
+		}
+		if job.Status != "canceled" {
+			return fmt.Errorf("job (%s) was not canceled, status is %s", rs.Primary.Attributes["url"], job.Status)
+		}
+	}
+
+	return nil
+}
+
 // testAccCheckJobPause is designed to force the acceptance test framework to wait
 // until a job is finished. This is needed when the associated inventory also must be
 // deleted.
@@ -544,6 +1361,19 @@ resource "aap_job" "test" {
 `, jobTemplateID)
 }
 
+func testAccJobWithTemplateName(jobTemplateID string) string {
+	return fmt.Sprintf(`
+data "aap_job_template" "test" {
+	id = %s
+}
+
+resource "aap_job" "test" {
+	job_template_name = data.aap_job_template.test.name
+	organization_name = data.aap_job_template.test.organization_name
+}
+`, jobTemplateID)
+}
+
 func testAccUpdateJobWithInventoryID(inventoryName, jobTemplateID string) string {
 	return fmt.Sprintf(`
 resource "aap_inventory" "test" {
@@ -575,6 +1405,37 @@ resource "aap_job" "test" {
 	job_template_id                     = %s
 	wait_for_completion                 = true
 	wait_for_completion_timeout_seconds = 300
+	stream_job_events                   = "tasks"
+}
+`, jobTemplateID)
+}
+
+func testAccJobIgnoreJobResults(jobTemplateID string, ignoreJobResults bool) string {
+	return fmt.Sprintf(`
+resource "aap_job" "test" {
+	job_template_id     = %s
+	wait_for_completion = true
+	ignore_job_results  = %t
+}
+`, jobTemplateID, ignoreJobResults)
+}
+
+func testAccJobCancelOnTimeout(jobTemplateID string) string {
+	return fmt.Sprintf(`
+resource "aap_job" "test" {
+	job_template_id                     = %s
+	wait_for_completion                 = true
+	wait_for_completion_timeout_seconds = 1
+	cancel_on_timeout                   = true
+}
+`, jobTemplateID)
+}
+
+func testAccJobCancelOnDestroy(jobTemplateID string) string {
+	return fmt.Sprintf(`
+resource "aap_job" "test" {
+	job_template_id   = %s
+	cancel_on_destroy = true
 }
 `, jobTemplateID)
 }
@@ -874,6 +1735,12 @@ func TestAccAAPJob_AllFieldsOnPrompt_MissingRequired(t *testing.T) {
 		PreCheck:                 func() { testAccJobResourcePreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Missing prompts are reported when planning
+			{
+				Config:      testAccJobAllFieldsOnPromptMissingRequired(jobTemplateID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(".*Missing required field.*"),
+			},
 			{
 				Config:      testAccJobAllFieldsOnPromptMissingRequired(jobTemplateID),
 				ExpectError: regexp.MustCompile(".*Missing required field.*"),
@@ -924,7 +1791,7 @@ func TestJobModelCreateRequestBody(t *testing.T) {
 		{
 			name: "unknown values",
 			input: JobResourceModel{JobModel: JobModel{
-				ExtraVars:   customtypes.NewAAPCustomStringUnknown(),
+				ExtraVars:   customtypes.NewAAPCustomVariablesUnknown(),
 				InventoryID: basetypes.NewInt64Unknown(),
 				TemplateID:  types.Int64Value(1),
 			}},
@@ -933,7 +1800,7 @@ func TestJobModelCreateRequestBody(t *testing.T) {
 		{
 			name: "null values",
 			input: JobResourceModel{JobModel: JobModel{
-				ExtraVars:   customtypes.NewAAPCustomStringNull(),
+				ExtraVars:   customtypes.NewAAPCustomVariablesNull(),
 				InventoryID: basetypes.NewInt64Null(),
 				TemplateID:  types.Int64Value(1),
 			}},
@@ -942,7 +1809,7 @@ func TestJobModelCreateRequestBody(t *testing.T) {
 		{
 			name: "extra vars only",
 			input: JobResourceModel{JobModel: JobModel{
-				ExtraVars:   customtypes.NewAAPCustomStringValue("{\"test_name\":\"extra_vars\", \"provider\":\"aap\"}"),
+				ExtraVars:   customtypes.NewAAPCustomVariablesValue("{\"test_name\":\"extra_vars\", \"provider\":\"aap\"}"),
 				InventoryID: basetypes.NewInt64Null(),
 			}},
 			expected: []byte(`{"extra_vars":"{\"test_name\":\"extra_vars\", \"provider\":\"aap\"}"}`),
@@ -950,7 +1817,7 @@ func TestJobModelCreateRequestBody(t *testing.T) {
 		{
 			name: "inventory vars only",
 			input: JobResourceModel{JobModel: JobModel{
-				ExtraVars:   customtypes.NewAAPCustomStringNull(),
+				ExtraVars:   customtypes.NewAAPCustomVariablesNull(),
 				InventoryID: basetypes.NewInt64Value(201),
 			}},
 			expected: []byte(`{"inventory": 201}`),
@@ -958,7 +1825,7 @@ func TestJobModelCreateRequestBody(t *testing.T) {
 		{
 			name: "combined",
 			input: JobResourceModel{JobModel: JobModel{
-				ExtraVars:   customtypes.NewAAPCustomStringValue("{\"test_name\":\"extra_vars\", \"provider\":\"aap\"}"),
+				ExtraVars:   customtypes.NewAAPCustomVariablesValue("{\"test_name\":\"extra_vars\", \"provider\":\"aap\"}"),
 				InventoryID: basetypes.NewInt64Value(3),
 			}},
 			expected: []byte(`{"inventory":3,"extra_vars":"{\"test_name\":\"extra_vars\", \"provider\":\"aap\"}"}`),
@@ -1014,7 +1881,7 @@ func TestJobModelCreateRequestBody(t *testing.T) {
 				Credentials:    basetypes.NewListValueMust(types.Int64Type, []attr.Value{types.Int64Value(1)}),
 				Labels:         basetypes.NewListValueMust(types.Int64Type, []attr.Value{types.Int64Value(5)}),
 				InstanceGroups: basetypes.NewListValueMust(types.Int64Type, []attr.Value{types.Int64Value(2)}),
-				ExtraVars:      customtypes.NewAAPCustomStringValue(`{"key":"value"}`),
+				ExtraVars:      customtypes.NewAAPCustomVariablesValue(`{"key":"value"}`),
 				Limit:          customtypes.NewAAPCustomStringValue("webservers"),
 				Verbosity:      basetypes.NewInt64Value(2),
 				DiffMode:       basetypes.NewBoolValue(true),
@@ -1161,15 +2028,21 @@ func TestJobModelCanJobBeLaunched(t *testing.T) {
 		{
 			name:         "extra_vars required but not provided",
 			launchConfig: JobLaunchAPIModel{AskVariablesOnLaunch: true},
-			model:        JobModel{TemplateID: types.Int64Value(1), ExtraVars: customtypes.NewAAPCustomStringNull()},
+			model:        JobModel{TemplateID: types.Int64Value(1), ExtraVars: customtypes.NewAAPCustomVariablesNull()},
 			expectError:  true,
 		},
 		{
 			name:           "extra_vars provided but not expected - warning",
 			launchConfig:   JobLaunchAPIModel{AskVariablesOnLaunch: false},
-			model:          JobModel{TemplateID: types.Int64Value(1), ExtraVars: customtypes.NewAAPCustomStringValue(`{"key": "value"}`)},
+			model:          JobModel{TemplateID: types.Int64Value(1), ExtraVars: customtypes.NewAAPCustomVariablesValue(`{"key": "value"}`)},
 			expectWarnings: true,
 		},
+		{
+			name:         "extra_vars not provided to a survey template",
+			launchConfig: JobLaunchAPIModel{SurveyEnabled: true},
+			model:        JobModel{TemplateID: types.Int64Value(1), ExtraVars: customtypes.NewAAPCustomVariablesNull()},
+			expectError:  false,
+		},
 		// inventory_id
 		{
 			name:         "inventory_id required but not provided",
@@ -1351,7 +2224,7 @@ func TestJobModelCanJobBeLaunched(t *testing.T) {
 			},
 			model: JobModel{
 				TemplateID:     types.Int64Value(1),
-				ExtraVars:      customtypes.NewAAPCustomStringValue(`{"key": "value"}`),
+				ExtraVars:      customtypes.NewAAPCustomVariablesValue(`{"key": "value"}`),
 				Limit:          customtypes.NewAAPCustomStringValue("all"),
 				InventoryID:    types.Int64Value(10),
 				Verbosity:      types.Int64Value(2),
@@ -1419,7 +2292,7 @@ func TestJobModelLaunchJob(t *testing.T) {
 			name: "launch fails when CanJobBeLaunched fails",
 			model: JobModel{
 				TemplateID: types.Int64Value(123),
-				ExtraVars:  customtypes.NewAAPCustomStringNull(),
+				ExtraVars:  customtypes.NewAAPCustomVariablesNull(),
 			},
 			launchConfig: JobLaunchAPIModel{
 				AskVariablesOnLaunch: true, // extra_vars required but not provided
@@ -1440,7 +2313,7 @@ func TestJobModelLaunchJob(t *testing.T) {
 			model: JobModel{
 				TemplateID:  types.Int64Value(123),
 				InventoryID: types.Int64Value(10),
-				ExtraVars:   customtypes.NewAAPCustomStringValue(`{"env": "prod"}`),
+				ExtraVars:   customtypes.NewAAPCustomVariablesValue(`{"env": "prod"}`),
 				Limit:       customtypes.NewAAPCustomStringValue("webservers"),
 				Verbosity:   types.Int64Value(3),
 			},
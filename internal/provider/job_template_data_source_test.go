@@ -62,7 +62,7 @@ func TestJobTemplateDataSourceParseHTTPResponse(t *testing.T) {
 						Description: tftypes.StringNull(),
 						Name:        tftypes.StringNull(),
 						NamedURL:    tftypes.StringNull(),
-						Variables:   customtypes.NewAAPCustomStringNull(),
+						Variables:   customtypes.NewAAPCustomVariablesNull(),
 					},
 					Organization:     tftypes.Int64Value(2),
 					OrganizationName: tftypes.StringNull(),
@@ -83,7 +83,7 @@ func TestJobTemplateDataSourceParseHTTPResponse(t *testing.T) {
 						Description: tftypes.StringValue("My Test Job Template"),
 						Name:        tftypes.StringValue("my job template"),
 						NamedURL:    tftypes.StringNull(),
-						Variables:   customtypes.NewAAPCustomStringValue("{\"foo\":\"bar\"}"),
+						Variables:   customtypes.NewAAPCustomVariablesValue("{\"foo\":\"bar\"}"),
 					},
 					Organization:     tftypes.Int64Value(2),
 					OrganizationName: tftypes.StringNull(),
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ProjectUpdateAction represents an action updating a project in AAP from its source control.
+type ProjectUpdateAction struct {
+	client ProviderHTTPClient
+}
+
+func NewProjectUpdateAction() action.Action {
+	return &ProjectUpdateAction{}
+}
+
+var (
+	_ action.Action                     = (*ProjectUpdateAction)(nil)
+	_ action.ActionWithConfigValidators = (*ProjectUpdateAction)(nil)
+)
+
+type ProjectUpdateActionModel struct {
+	ProjectID                types.Int64  `tfsdk:"project_id"`
+	ProjectName              types.String `tfsdk:"project_name"`
+	OrganizationName         types.String `tfsdk:"organization_name"`
+	WaitForCompletion        types.Bool   `tfsdk:"wait_for_completion"`
+	WaitForCompletionTimeout types.Int64  `tfsdk:"wait_for_completion_timeout_seconds"`
+}
+
+// Schema defines the schema for the project update action
+func (a *ProjectUpdateAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "ID of the project. Required unless `project_name` and `organization_name` are provided.",
+			},
+			"project_name": schema.StringAttribute{
+				Optional: true,
+				Description: "Name of the project, to reference it together with `organization_name` instead of " +
+					"`project_id`.",
+			},
+			"organization_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the organization of the project referenced by `project_name`.",
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+				Description: "When this is set to `true`, Terraform will wait until the project update reaches " +
+					"any final status and then, proceeds with the following resource operation",
+			},
+			"wait_for_completion_timeout_seconds": schema.Int64Attribute{
+				Optional: true,
+				Description: "Sets the maximum amount of seconds Terraform will wait before timing out the update, " +
+					"and the action will fail. Default value of `120`",
+			},
+		},
+		MarkdownDescription: "Updates an AAP project from its source control.\n\n" +
+			"This action launches a project update in AAP, such as after pushing playbook changes. \n" +
+			"Moreover, you can set `wait_for_completion` to true, then Terraform will " +
+			"wait until the update reaches any final state before continuing. An update that does not succeed " +
+			"fails the action with the last lines of its standard output. " +
+			"You can also tweak `wait_for_completion_timeout_seconds` to control the timeout limit.",
+	}
+}
+
+// ConfigValidators returns the configuration validators of the project update action.
+func (a *ProjectUpdateAction) ConfigValidators(_ context.Context) []action.ConfigValidator {
+	return namedActionConfigValidators("project_id", "project_name", "organization_name")
+}
+
+// ProjectURL returns the URL of the project, referenced by ID or by its named URL.
+func (r *ProjectUpdateActionModel) ProjectURL(client ProviderHTTPClient) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	projectsURI := path.Join(client.getAPIEndpoint(), "projects")
+
+	projectID := r.ProjectID.ValueInt64()
+	if r.ProjectID.IsNull() {
+		apiModel := &BaseDetailAPIModelWithOrg{
+			BaseDetailAPIModel: BaseDetailAPIModel{Name: r.ProjectName.ValueString()},
+			SummaryFields: SummaryFieldsAPIModel{
+				Organization: SummaryField{Name: r.OrganizationName.ValueString()},
+			},
+		}
+		namedURL, err := apiModel.CreateNamedURL(projectsURI)
+		if err != nil {
+			diags.AddError("Error resolving the project ID", err.Error())
+			return "", diags
+		}
+		projectID, diags = ResolveNamedURLID(client, namedURL)
+		if diags.HasError() {
+			return "", diags
+		}
+	}
+
+	return path.Join(projectsURI, strconv.FormatInt(projectID, 10)), diags
+}
+
+// Invoke executes the project update action.
+func (a *ProjectUpdateAction) Invoke(ctx context.Context, req action.InvokeRequest, response *action.InvokeResponse) {
+	var config ProjectUpdateActionModel
+
+	response.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set default timeout if not provided
+	if config.WaitForCompletionTimeout.IsNull() {
+		config.WaitForCompletionTimeout = types.Int64Value(waitForCompletionTimeoutDefault)
+	}
+
+	projectURL, diags := config.ProjectURL(a.client)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	options := SourceUpdateOptions{
+		Kind:              "project update",
+		WaitForCompletion: config.WaitForCompletion.ValueBool(),
+		Timeout:           time.Duration(config.WaitForCompletionTimeout.ValueInt64()) * time.Second,
+	}
+	progress := func(message string) {
+		response.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+	response.Diagnostics.Append(RunSourceUpdate(ctx, a.client, projectURL, options, progress)...)
+}
+
+// Configure configures the project update action with the provider client
+func (a *ProjectUpdateAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+// Metadata returns the action metadata
+func (a *ProjectUpdateAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_update"
+}
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	fwaction "github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.uber.org/mock/gomock"
+)
+
+// TestProjectUpdateActionSchema tests the Schema function
+func TestProjectUpdateActionSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	schemaResponse := fwaction.SchemaResponse{}
+	NewProjectUpdateAction().Schema(ctx, fwaction.SchemaRequest{}, &schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+// TestProjectUpdateActionMetadata tests the Metadata function
+func TestProjectUpdateActionMetadata(t *testing.T) {
+	t.Parallel()
+
+	metadataResponse := fwaction.MetadataResponse{}
+	NewProjectUpdateAction().Metadata(t.Context(), fwaction.MetadataRequest{ProviderTypeName: "aap"}, &metadataResponse)
+
+	expected := "aap_project_update"
+	if metadataResponse.TypeName != expected {
+		t.Errorf("Expected metadata TypeName %q, received %q", expected, metadataResponse.TypeName)
+	}
+}
+
+// projectUpdateActionConfig returns the configuration of the project update action with the given values.
+func projectUpdateActionConfig(t *testing.T, projectID *int64, projectName *string, organizationName *string, wait *bool) tfsdk.Config {
+	ctx := t.Context()
+	schemaResp := fwaction.SchemaResponse{}
+	NewProjectUpdateAction().Schema(ctx, fwaction.SchemaRequest{}, &schemaResp)
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"project_id":                          valueOrNil(tftypes.Number, projectID),
+			"project_name":                        valueOrNil(tftypes.String, projectName),
+			"organization_name":                   valueOrNil(tftypes.String, organizationName),
+			"wait_for_completion":                 valueOrNil(tftypes.Bool, wait),
+			"wait_for_completion_timeout_seconds": valueOrNil[int64](tftypes.Number, nil),
+		}),
+	}
+}
+
+func TestProjectUpdateActionConfigValidators(t *testing.T) {
+	projectID := int64(6)
+	projectName := "Demo Project"
+	organizationName := "Default"
+
+	var testTable = []struct {
+		name             string
+		projectID        *int64
+		projectName      *string
+		organizationName *string
+		expectError      bool
+	}{
+		{name: "project id", projectID: &projectID},
+		{name: "project name", projectName: &projectName, organizationName: &organizationName},
+		{name: "no project", expectError: true},
+		{name: "project id and name", projectID: &projectID, projectName: &projectName, organizationName: &organizationName, expectError: true},
+		{name: "project name without organization", projectName: &projectName, expectError: true},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := t.Context()
+			config := projectUpdateActionConfig(t, test.projectID, test.projectName, test.organizationName, nil)
+
+			var diags diag.Diagnostics
+			for _, validator := range NewProjectUpdateAction().(*ProjectUpdateAction).ConfigValidators(ctx) {
+				resp := &fwaction.ValidateConfigResponse{}
+				validator.ValidateAction(ctx, fwaction.ValidateConfigRequest{Config: config}, resp)
+				diags.Append(resp.Diagnostics...)
+			}
+			if test.expectError != diags.HasError() {
+				t.Errorf("Expected error (%t), got (%v)", test.expectError, diags.Errors())
+			}
+		})
+	}
+}
+
+// TestProjectUpdateActionInvoke tests the full Invoke function
+func TestProjectUpdateActionInvoke(t *testing.T) {
+	projectID := int64(6)
+	projectName := "Demo Project"
+	organizationName := "Default"
+	waitTrue := true
+
+	testTable := []struct {
+		name             string
+		projectID        *int64
+		projectName      *string
+		organizationName *string
+		wait             *bool
+		setupMock        func(*MockProviderHTTPClient)
+		expectedProgress []string
+	}{
+		{
+			name:      "update by project id",
+			projectID: &projectID,
+			setupMock: func(mock *MockProviderHTTPClient) {
+				mockSourceUpdate(mock, "/api/v2/projects/6", "/api/v2/project_updates/10/")
+			},
+			expectedProgress: []string{"AAP project update launched, URL: /api/v2/project_updates/10/"},
+		},
+		{
+			name:             "update by project name and wait",
+			projectName:      &projectName,
+			organizationName: &organizationName,
+			wait:             &waitTrue,
+			setupMock: func(mock *MockProviderHTTPClient) {
+				mock.EXPECT().Get("/api/v2/projects/Demo Project++Default").Return([]byte(`{"id": 6}`), diag.Diagnostics{})
+				mockSourceUpdate(mock, "/api/v2/projects/6", "/api/v2/project_updates/10/")
+				mock.EXPECT().Get("/api/v2/project_updates/10/").Return([]byte(`{"status": "successful"}`), diag.Diagnostics{})
+			},
+			expectedProgress: []string{
+				"AAP project update launched, URL: /api/v2/project_updates/10/",
+				"AAP project update at: /api/v2/project_updates/10/ is in status: successful",
+			},
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockProviderHTTPClient(ctrl)
+			mockClient.EXPECT().getAPIEndpoint().Return("/api/v2")
+			tc.setupMock(mockClient)
+
+			var progress []string
+			resp := &fwaction.InvokeResponse{
+				SendProgress: func(event fwaction.InvokeProgressEvent) {
+					progress = append(progress, event.Message)
+				},
+			}
+			action := &ProjectUpdateAction{client: mockClient}
+			config := projectUpdateActionConfig(t, tc.projectID, tc.projectName, tc.organizationName, tc.wait)
+			action.Invoke(t.Context(), fwaction.InvokeRequest{Config: config}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics.Errors())
+			}
+			if !reflect.DeepEqual(tc.expectedProgress, progress) {
+				t.Errorf("Expected progress (%v), got (%v)", tc.expectedProgress, progress)
+			}
+		})
+	}
+}
+
+func TestAccAAPProjectUpdateAction_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectUpdateAction(),
+			},
+		},
+	})
+}
+
+func testAccProjectUpdateAction() string {
+	return `
+resource "terraform_data" "trigger" {
+	input = "project-update"
+	lifecycle {
+		action_trigger {
+			events  = [after_create]
+			actions = [action.aap_project_update.test]
+		}
+	}
+}
+
+action "aap_project_update" "test" {
+	config {
+		project_name        = "Demo Project"
+		organization_name   = "Default"
+		wait_for_completion = true
+	}
+}
+`
+}
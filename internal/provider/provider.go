@@ -187,6 +187,9 @@ func (p *aapProvider) DataSources(_ context.Context) []func() datasource.DataSou
 		NewWorkflowJobTemplateDataSource,
 		NewOrganizationDataSource,
 		NewEDAEventStreamDataSource,
+		NewHostDataSource,
+		NewHostsDataSource,
+		NewJobDataSource,
 	}
 }
 
@@ -198,6 +201,8 @@ func (p *aapProvider) Resources(_ context.Context) []func() resource.Resource {
 		NewWorkflowJobResource,
 		NewGroupResource,
 		NewHostResource,
+		NewInventoryHostsResource,
+		NewEDAEventStreamResource,
 	}
 }
 
@@ -207,6 +212,12 @@ func (p *aapProvider) Actions(_ context.Context) []func() action.Action {
 		NewEDAEventStreamPostAction,
 		NewJobAction,
 		NewWorkflowJobAction,
+		NewJobRelaunchAction,
+		NewAdHocCommandAction,
+		NewProjectUpdateAction,
+		NewInventorySourceUpdateAction,
+		NewWorkflowApprovalAction,
+		NewBulkJobLaunchAction,
 	}
 }
 
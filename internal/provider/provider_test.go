@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
 	"os"
@@ -62,7 +63,8 @@ func testMethodResource(method string, urlPath string) ([]byte, error) {
 	return testMethodResourceWithParams(method, urlPath, nil)
 }
 
-func testMethodResourceWithParams(method string, urlPath string, params map[string]string) ([]byte, error) {
+// testAccClient returns a client configured from the acceptance tests environment variables.
+func testAccClient() (*AAPClient, error) {
 	// Prefer AAP_HOSTNAME, fallback to AAP_HOST
 	host := os.Getenv("AAP_HOSTNAME")
 	if host == "" {
@@ -87,8 +89,17 @@ func testMethodResourceWithParams(method string, urlPath string, params map[stri
 	if diags.HasError() {
 		return nil, fmt.Errorf("%v", diags.Errors())
 	}
+	return client, nil
+}
+
+func testMethodResourceWithParams(method string, urlPath string, params map[string]string) ([]byte, error) {
+	client, err := testAccClient()
+	if err != nil {
+		return nil, err
+	}
 
 	var body []byte
+	var diags diag.Diagnostics
 	switch method {
 	case http.MethodGet:
 		if params != nil {
@@ -115,6 +126,19 @@ func testDeleteResource(urlPath string) ([]byte, error) {
 	return testMethodResource(http.MethodDelete, urlPath)
 }
 
+func testUpdateResource(urlPath string, requestBody []byte) ([]byte, error) {
+	client, err := testAccClient()
+	if err != nil {
+		return nil, err
+	}
+
+	body, diags := client.Update(urlPath, bytes.NewReader(requestBody))
+	if diags.HasError() {
+		return nil, fmt.Errorf("%v", diags.Errors())
+	}
+	return body, nil
+}
+
 func TestReadValues(t *testing.T) {
 	testTable := []struct {
 		name               string
@@ -608,7 +632,7 @@ func TestActions(t *testing.T) {
 		version: "test",
 	}
 	actions := p.Actions(t.Context())
-	expected := 3
+	expected := 9
 	actual := len(actions)
 	if expected != actual {
 		t.Errorf("Expected provider.Actions to return %v actions, found %v", expected, actual)
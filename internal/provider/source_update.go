@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// sourceUpdateStdoutLines is the number of trailing lines of the standard output of a failed update
+// reported in its error.
+const sourceUpdateStdoutLines = 20
+
+// SourceUpdateAPIModel represents the update of a project or an inventory source.
+// POST /api/controller/v2/projects/<id>/update/
+// POST /api/controller/v2/inventory_sources/<id>/update/
+type SourceUpdateAPIModel struct {
+	URL    string `json:"url"`
+	Status string `json:"status"`
+}
+
+// SourceUpdateOptions are the options of the update of a project or an inventory source.
+type SourceUpdateOptions struct {
+	// Kind describes the updated source in messages, such as "project update".
+	Kind string
+	// WaitForCompletion waits for the update to reach a final state.
+	WaitForCompletion bool
+	// Timeout is the time waited for the update to reach a final state.
+	Timeout time.Duration
+}
+
+// SourceUpdateProgressFunc is called with the messages reporting the progress of an update.
+type SourceUpdateProgressFunc func(message string)
+
+// LaunchSourceUpdate launches the update of the project or inventory source at the given URL.
+func LaunchSourceUpdate(client ProviderHTTPClient, sourceURL string) (SourceUpdateAPIModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var update SourceUpdateAPIModel
+
+	resp, body, err := client.doRequest(http.MethodPost, path.Join(sourceURL, "update"), nil, nil)
+	diags.Append(ValidateResponse(resp, body, err, []int{http.StatusAccepted})...)
+	if diags.HasError() {
+		return update, diags
+	}
+
+	err = json.Unmarshal(body, &update)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+	}
+	return update, diags
+}
+
+// RunSourceUpdate launches the update of the project or inventory source at the given URL and, when requested,
+// waits for it to reach a final state. An update that does not succeed is reported as an error with the
+// last lines of its standard output.
+func RunSourceUpdate(
+	ctx context.Context,
+	client ProviderHTTPClient,
+	sourceURL string,
+	options SourceUpdateOptions,
+	progress SourceUpdateProgressFunc,
+) diag.Diagnostics {
+	update, diags := LaunchSourceUpdate(client, sourceURL)
+	if diags.HasError() {
+		return diags
+	}
+
+	progress(fmt.Sprintf("AAP %s launched, URL: %s", options.Kind, update.URL))
+	tflog.Debug(ctx, options.Kind+" launched", map[string]interface{}{
+		"url":    update.URL,
+		"status": update.Status,
+	})
+
+	if !options.WaitForCompletion {
+		return diags
+	}
+
+	var status string
+	retryProgressFunc := func(status string) {
+		progress(fmt.Sprintf("AAP %s at: %s is in status: %s", options.Kind, update.URL, status))
+	}
+	err := retry.RetryContext(
+		ctx,
+		options.Timeout,
+		retryUntilAAPJobReachesAnyFinalState(ctx, client, retryProgressFunc, update.URL, &status),
+	)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("error when waiting for AAP %s to complete", options.Kind), err.Error())
+		return diags
+	}
+
+	if status != statusSuccessfulConst {
+		detail := fmt.Sprintf("API Path: %s", update.URL)
+		stdout, stdoutDiags := GetJobStdoutTail(client, update.URL, sourceUpdateStdoutLines)
+		if stdoutDiags.HasError() {
+			tflog.Warn(ctx, "unable to retrieve the standard output of the "+options.Kind, map[string]interface{}{
+				"url": update.URL,
+			})
+		} else if stdout != "" {
+			detail += "\n\n" + stdout
+		}
+		diags.AddError(fmt.Sprintf("AAP %s %s", options.Kind, status), detail)
+	}
+	return diags
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"go.uber.org/mock/gomock"
+)
+
+// mockSourceUpdate mocks the launch of the update of the source at the given URL, returning the given update URL.
+func mockSourceUpdate(mock *MockProviderHTTPClient, sourceURL string, updateURL string) {
+	mock.EXPECT().doRequest(http.MethodPost, sourceURL+"/update", nil, nil).Return(
+		&http.Response{StatusCode: http.StatusAccepted},
+		[]byte(`{"url": "`+updateURL+`", "status": "pending"}`),
+		nil,
+	)
+}
+
+func TestLaunchSourceUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockProviderHTTPClient(ctrl)
+	mockSourceUpdate(mockClient, "/api/v2/projects/6", "/api/v2/project_updates/10/")
+
+	update, diags := LaunchSourceUpdate(mockClient, "/api/v2/projects/6")
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+	if update.URL != "/api/v2/project_updates/10/" || update.Status != "pending" {
+		t.Errorf("Unexpected update %v", update)
+	}
+}
+
+func TestRunSourceUpdate(t *testing.T) {
+	var testTable = []struct {
+		name             string
+		wait             bool
+		status           string
+		stdout           string
+		expectedProgress []string
+		expectedError    string
+	}{
+		{
+			name:             "without waiting",
+			expectedProgress: []string{"AAP project update launched, URL: /api/v2/project_updates/10/"},
+		},
+		{
+			name:   "successful update",
+			wait:   true,
+			status: statusSuccessfulConst,
+			expectedProgress: []string{
+				"AAP project update launched, URL: /api/v2/project_updates/10/",
+				"AAP project update at: /api/v2/project_updates/10/ is in status: successful",
+			},
+		},
+		{
+			name:   "failed update",
+			wait:   true,
+			status: "failed",
+			stdout: "PLAY [Update source tree]\nfatal: [localhost]: FAILED! => Repository not found\n",
+			expectedProgress: []string{
+				"AAP project update launched, URL: /api/v2/project_updates/10/",
+				"AAP project update at: /api/v2/project_updates/10/ is in status: failed",
+			},
+			expectedError: "fatal: [localhost]: FAILED! => Repository not found",
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockProviderHTTPClient(ctrl)
+			mockSourceUpdate(mockClient, "/api/v2/projects/6", "/api/v2/project_updates/10/")
+			if test.wait {
+				mockClient.EXPECT().Get("/api/v2/project_updates/10/").Return([]byte(`{"status": "`+test.status+`"}`), diag.Diagnostics{})
+			}
+			if test.stdout != "" {
+				mockClient.EXPECT().GetWithParams("/api/v2/project_updates/10/stdout", map[string]string{"format": "txt"}).
+					Return([]byte(test.stdout), diag.Diagnostics{})
+			}
+
+			var progress []string
+			options := SourceUpdateOptions{Kind: "project update", WaitForCompletion: test.wait, Timeout: time.Minute}
+			diags := RunSourceUpdate(t.Context(), mockClient, "/api/v2/projects/6", options, func(message string) {
+				progress = append(progress, message)
+			})
+
+			if test.expectedError == "" && diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			if test.expectedError != "" {
+				if !diags.HasError() {
+					t.Fatal("Expected an error")
+				}
+				if !strings.Contains(diags.Errors()[0].Detail(), test.expectedError) {
+					t.Errorf("Expected error detail to contain (%s), got (%s)", test.expectedError, diags.Errors()[0].Detail())
+				}
+			}
+			if !reflect.DeepEqual(test.expectedProgress, progress) {
+				t.Errorf("Expected progress (%v), got (%v)", test.expectedProgress, progress)
+			}
+		})
+	}
+}
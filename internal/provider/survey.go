@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// SurveySpecAPIModel represents the survey of a template.
+// /api/controller/v2/job_templates/<id>/survey_spec/
+type SurveySpecAPIModel struct {
+	Spec []SurveyQuestionAPIModel `json:"spec"`
+}
+
+// SurveyQuestionAPIModel represents a question of a survey. The choices are either
+// a list or a newline separated string, and the min and max either numbers or strings,
+// depending on the version of AAP and on how the survey was created.
+type SurveyQuestionAPIModel struct {
+	QuestionName string `json:"question_name"`
+	Variable     string `json:"variable"`
+	Type         string `json:"type"`
+	Min          any    `json:"min"`
+	Max          any    `json:"max"`
+	Choices      any    `json:"choices"`
+}
+
+// GetSurveySpec retrieves the survey of the template at the given survey_spec URL.
+func GetSurveySpec(client ProviderHTTPClient, surveySpecURL string) (SurveySpecAPIModel, diag.Diagnostics) {
+	var spec SurveySpecAPIModel
+
+	body, diags := client.Get(surveySpecURL)
+	if diags.HasError() {
+		return spec, diags
+	}
+
+	err := json.Unmarshal(body, &spec)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+	}
+	return spec, diags
+}
+
+// ValidateSurveySpecAnswers validates the answers provided in extra_vars against the survey of the template
+// at the given survey_spec URL. When the survey cannot be retrieved or parsed, the answers are not validated
+// and a warning is returned instead, AAP validates them when the job is launched.
+func ValidateSurveySpecAnswers(client ProviderHTTPClient, surveySpecURL string, extraVars customtypes.AAPCustomVariablesValue) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	survey, surveyDiags := GetSurveySpec(client, surveySpecURL)
+	if surveyDiags.HasError() {
+		var problems []string
+		for _, d := range surveyDiags.Errors() {
+			problems = append(problems, d.Summary()+": "+d.Detail())
+		}
+		diags.AddAttributeWarning(
+			tfpath.Root("extra_vars"),
+			"Survey answers not validated",
+			fmt.Sprintf("Unable to retrieve the survey from %s, the answers in extra_vars are validated by AAP when the job is launched. %s",
+				surveySpecURL, strings.Join(problems, ", ")),
+		)
+		return diags
+	}
+
+	return ValidateSurveyAnswers(extraVars, survey)
+}
+
+// surveyBound returns the min or max of a question, or nil when it is not set or not a number.
+func surveyBound(value any) *float64 {
+	var number float64
+	switch value := value.(type) {
+	case float64:
+		number = value
+	case string:
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil
+		}
+		number = parsed
+	default:
+		return nil
+	}
+	return &number
+}
+
+// ChoiceList returns the choices of a multiple choice question.
+func (q *SurveyQuestionAPIModel) ChoiceList() []string {
+	var choices []string
+	switch value := q.Choices.(type) {
+	case string:
+		choices = strings.Split(value, "\n")
+	case []any:
+		for _, choice := range value {
+			choices = append(choices, fmt.Sprint(choice))
+		}
+	}
+
+	result := make([]string, 0, len(choices))
+	for _, choice := range choices {
+		if choice = strings.TrimSpace(choice); choice != "" {
+			result = append(result, choice)
+		}
+	}
+	return result
+}
+
+// Validate returns a description of the problem with the answer to the question,
+// or an empty string if the answer is valid.
+func (q *SurveyQuestionAPIModel) Validate(answer any) string {
+	minimum, maximum := surveyBound(q.Min), surveyBound(q.Max)
+	switch q.Type {
+	case "text", "textarea", "password":
+		text, ok := answer.(string)
+		if !ok {
+			return "must be a string"
+		}
+		length := float64(len([]rune(text)))
+		if (minimum != nil && length < *minimum) || (maximum != nil && length > *maximum) {
+			return "must be " + describeRange(minimum, maximum, "characters long")
+		}
+	case "integer":
+		number, ok := answer.(int)
+		if !ok {
+			return "must be an integer"
+		}
+		if (minimum != nil && float64(number) < *minimum) || (maximum != nil && float64(number) > *maximum) {
+			return "must be " + describeRange(minimum, maximum, "")
+		}
+	case "float":
+		var number float64
+		switch value := answer.(type) {
+		case int:
+			number = float64(value)
+		case float64:
+			number = value
+		default:
+			return "must be a number"
+		}
+		if (minimum != nil && number < *minimum) || (maximum != nil && number > *maximum) {
+			return "must be " + describeRange(minimum, maximum, "")
+		}
+	case "multiplechoice":
+		choices := q.ChoiceList()
+		if !slices.Contains(choices, fmt.Sprint(answer)) {
+			return fmt.Sprintf("must be one of '%s'", strings.Join(choices, "', '"))
+		}
+	case "multiselect":
+		choices := q.ChoiceList()
+		var selected []string
+		switch value := answer.(type) {
+		case string:
+			selected = strings.Split(value, "\n")
+		case []any:
+			for _, item := range value {
+				selected = append(selected, fmt.Sprint(item))
+			}
+		default:
+			return "must be a list"
+		}
+		for _, item := range selected {
+			if !slices.Contains(choices, item) {
+				return fmt.Sprintf("must only contain '%s'", strings.Join(choices, "', '"))
+			}
+		}
+	}
+	return ""
+}
+
+// describeRange describes the min and max of a question, such as "between 1 and 5".
+func describeRange(minimum *float64, maximum *float64, unit string) string {
+	format := func(value float64) string {
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+
+	var description string
+	switch {
+	case minimum != nil && maximum != nil:
+		description = fmt.Sprintf("between %s and %s", format(*minimum), format(*maximum))
+	case minimum != nil:
+		description = "at least " + format(*minimum)
+	default:
+		description = "at most " + format(*maximum)
+	}
+	if unit != "" {
+		description += " " + unit
+	}
+	return description
+}
+
+// ValidateSurveyAnswers returns an error on extra_vars for each survey question whose answer is invalid.
+// Unanswered questions are not validated here, the required ones are listed in variables_needed_to_start.
+// Unknown or invalid extra_vars are not validated.
+func ValidateSurveyAnswers(extraVars customtypes.AAPCustomVariablesValue, survey SurveySpecAPIModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !IsValueProvided(extraVars) {
+		return diags
+	}
+	variables, err := DecodeVariables(extraVars.ValueString())
+	if err != nil {
+		return diags
+	}
+
+	for _, question := range survey.Spec {
+		answer, ok := variables[question.Variable]
+		if !ok {
+			continue
+		}
+		if problem := question.Validate(answer); problem != "" {
+			diags.AddAttributeError(
+				tfpath.Root("extra_vars"),
+				"Invalid survey answer",
+				fmt.Sprintf("The answer to the survey question '%s' (variable '%s') %s", question.QuestionName, question.Variable, problem),
+			)
+		}
+	}
+
+	return diags
+}
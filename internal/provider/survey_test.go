@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSurveyQuestionValidate(t *testing.T) {
+	bound := func(value float64) any { return value }
+
+	var testTable = []struct {
+		name     string
+		question SurveyQuestionAPIModel
+		answer   any
+		expected string
+	}{
+		{name: "valid text", question: SurveyQuestionAPIModel{Type: "text", Min: bound(2), Max: bound(5)}, answer: "eu", expected: ""},
+		{name: "text too short", question: SurveyQuestionAPIModel{Type: "text", Min: bound(3), Max: bound(5)}, answer: "eu", expected: "must be between 3 and 5 characters long"},
+		{name: "password too long", question: SurveyQuestionAPIModel{Type: "password", Max: bound(4)}, answer: "secret", expected: "must be at most 4 characters long"},
+		{
+			name:     "text with string bounds",
+			question: SurveyQuestionAPIModel{Type: "text", Min: "2", Max: ""},
+			answer:   "e",
+			expected: "must be at least 2 characters long",
+		},
+		{name: "text not a string", question: SurveyQuestionAPIModel{Type: "textarea"}, answer: 3, expected: "must be a string"},
+		{name: "valid integer", question: SurveyQuestionAPIModel{Type: "integer", Min: bound(1), Max: bound(5)}, answer: 3, expected: ""},
+		{name: "integer out of range", question: SurveyQuestionAPIModel{Type: "integer", Min: bound(1)}, answer: 0, expected: "must be at least 1"},
+		{name: "integer not an integer", question: SurveyQuestionAPIModel{Type: "integer"}, answer: 1.5, expected: "must be an integer"},
+		{name: "valid float", question: SurveyQuestionAPIModel{Type: "float", Min: bound(0.5), Max: bound(2.5)}, answer: 2, expected: ""},
+		{name: "float out of range", question: SurveyQuestionAPIModel{Type: "float", Min: bound(0.5), Max: bound(2.5)}, answer: 2.75, expected: "must be between 0.5 and 2.5"},
+		{name: "float not a number", question: SurveyQuestionAPIModel{Type: "float"}, answer: "two", expected: "must be a number"},
+		{name: "valid choice", question: SurveyQuestionAPIModel{Type: "multiplechoice", Choices: "dev\ntest"}, answer: "test", expected: ""},
+		{name: "invalid choice", question: SurveyQuestionAPIModel{Type: "multiplechoice", Choices: []any{"dev", "test"}}, answer: "prod", expected: "must be one of 'dev', 'test'"},
+		{name: "valid selection", question: SurveyQuestionAPIModel{Type: "multiselect", Choices: []any{"a", "b", "c"}}, answer: []any{"a", "c"}, expected: ""},
+		{name: "invalid selection", question: SurveyQuestionAPIModel{Type: "multiselect", Choices: "a\nb"}, answer: "a\nz", expected: "must only contain 'a', 'b'"},
+		{name: "selection not a list", question: SurveyQuestionAPIModel{Type: "multiselect", Choices: "a\nb"}, answer: 1, expected: "must be a list"},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual := test.question.Validate(test.answer)
+			if actual != test.expected {
+				t.Errorf("Expected (%q) not equal to actual (%q)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestValidateSurveyAnswers(t *testing.T) {
+	size := float64(3)
+	survey := SurveySpecAPIModel{Spec: []SurveyQuestionAPIModel{
+		{QuestionName: "Region", Variable: "region", Type: "multiplechoice", Choices: "eu\nus"},
+		{QuestionName: "Cluster size", Variable: "size", Type: "integer", Max: size},
+		{QuestionName: "Owner", Variable: "owner", Type: "text"},
+	}}
+
+	var testTable = []struct {
+		name      string
+		extraVars customtypes.AAPCustomVariablesValue
+		expected  []string
+	}{
+		{name: "null extra vars", extraVars: customtypes.NewAAPCustomVariablesNull()},
+		{name: "unknown extra vars", extraVars: customtypes.NewAAPCustomVariablesUnknown()},
+		{name: "valid answers", extraVars: customtypes.NewAAPCustomVariablesValue(`{"region": "eu", "size": 3}`)},
+		{
+			name:      "invalid answers",
+			extraVars: customtypes.NewAAPCustomVariablesValue("region: asia\nsize: 5\nowner: ops\n"),
+			expected: []string{
+				"The answer to the survey question 'Region' (variable 'region') must be one of 'eu', 'us'",
+				"The answer to the survey question 'Cluster size' (variable 'size') must be at most 3",
+			},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			diags := ValidateSurveyAnswers(test.extraVars, survey)
+			var actual []string
+			for _, d := range diags.Errors() {
+				actual = append(actual, d.Detail())
+			}
+			if !reflect.DeepEqual(test.expected, actual) {
+				t.Errorf("Expected (%v) not equal to actual (%v)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestGetSurveySpec(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockProviderHTTPClient(ctrl)
+	mockClient.EXPECT().Get("/api/v2/job_templates/1/survey_spec").Return(
+		[]byte(`{"name": "", "spec": [{"question_name": "Region", "variable": "region", "type": "multiplechoice", `+
+			`"required": true, "min": null, "max": null, "choices": ["eu", "us"]}]}`),
+		diag.Diagnostics{},
+	)
+
+	survey, diags := GetSurveySpec(mockClient, "/api/v2/job_templates/1/survey_spec")
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+
+	expected := SurveySpecAPIModel{Spec: []SurveyQuestionAPIModel{
+		{QuestionName: "Region", Variable: "region", Type: "multiplechoice", Choices: []any{"eu", "us"}},
+	}}
+	if !reflect.DeepEqual(expected, survey) {
+		t.Errorf("Expected (%v) not equal to actual (%v)", expected, survey)
+	}
+}
+
+func TestValidateSurveySpecAnswers(t *testing.T) {
+	fetchError := diag.Diagnostics{}
+	fetchError.AddError("Unexpected HTTP status code received for GET request to path /api/v2/job_templates/1/survey_spec", "Expected one of ([200]), got (403)")
+
+	var testTable = []struct {
+		name             string
+		body             string
+		diags            diag.Diagnostics
+		expectedErrors   []string
+		expectedWarnings []string
+	}{
+		{
+			name:           "invalid answer",
+			body:           `{"spec": [{"question_name": "Region", "variable": "region", "type": "multiplechoice", "choices": "eu\nus"}]}`,
+			expectedErrors: []string{"Invalid survey answer"},
+		},
+		{name: "survey not retrieved", diags: fetchError, expectedWarnings: []string{"Survey answers not validated"}},
+		{name: "survey not parsed", body: `{"spec": "none"}`, expectedWarnings: []string{"Survey answers not validated"}},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockProviderHTTPClient(ctrl)
+			mockClient.EXPECT().Get("/api/v2/job_templates/1/survey_spec").Return([]byte(test.body), test.diags)
+
+			diags := ValidateSurveySpecAnswers(mockClient, "/api/v2/job_templates/1/survey_spec", customtypes.NewAAPCustomVariablesValue(`{"region": "asia"}`))
+			var errors, warnings []string
+			for _, d := range diags.Errors() {
+				errors = append(errors, d.Summary())
+			}
+			for _, d := range diags.Warnings() {
+				warnings = append(warnings, d.Summary())
+			}
+			if !reflect.DeepEqual(test.expectedErrors, errors) {
+				t.Errorf("Expected errors (%v), got (%v)", test.expectedErrors, diags.Errors())
+			}
+			if !reflect.DeepEqual(test.expectedWarnings, warnings) {
+				t.Errorf("Expected warnings (%v), got (%v)", test.expectedWarnings, diags.Warnings())
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
 )
 
 // ReturnAAPNamedURL returns an AAP named URL for the given model and URI.
@@ -36,6 +37,11 @@ func IsValueProvidedOrPromised(value attr.Value) bool {
 	return (!value.IsNull() || value.IsUnknown())
 }
 
+// IsValueProvided checks if a Terraform attribute value is provided and known.
+func IsValueProvided(value attr.Value) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
 // ValidateResponse validates an HTTP response against expected status codes and returns diagnostics.
 func ValidateResponse(resp *http.Response, body []byte, err error, expectedStatuses []int) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -93,12 +99,12 @@ func ParseNormalizedValue(variables string) jsontypes.Normalized {
 	return jsontypes.NewNormalizedNull()
 }
 
-// ParseAAPCustomStringValue parses a variables string into a customtypes.AAPCustomStringValue.
-func ParseAAPCustomStringValue(variables string) customtypes.AAPCustomStringValue {
+// ParseAAPCustomVariablesValue parses a variables string into a customtypes.AAPCustomVariablesValue.
+func ParseAAPCustomVariablesValue(variables string) customtypes.AAPCustomVariablesValue {
 	if variables != "" {
-		return customtypes.NewAAPCustomStringValue(variables)
+		return customtypes.NewAAPCustomVariablesValue(variables)
 	}
-	return customtypes.NewAAPCustomStringNull()
+	return customtypes.NewAAPCustomVariablesNull()
 }
 
 // ConvertListToInt64Slice converts a types.List of Int64 to []int64.
@@ -125,3 +131,14 @@ func ConvertListToInt64Slice(list types.List) []int64 {
 	}
 	return result
 }
+
+// DecodeVariables decodes variables provided as either a JSON or YAML string, such as extra_vars.
+// An empty string decodes to an empty map.
+func DecodeVariables(variables string) (map[string]any, error) {
+	result := map[string]any{}
+	// YAML is a superset of JSON, so both formats are decoded by the YAML decoder
+	if err := yaml.Unmarshal([]byte(variables), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"regexp"
 	"testing"
 
@@ -147,6 +148,43 @@ func TestIsValueProvidedOrPromised(t *testing.T) {
 	}
 }
 
+func TestIsValueProvided(t *testing.T) {
+	var testTable = []struct {
+		testName string
+		value    attr.Value
+		expected bool
+	}{
+		{
+			testName: "value is int64(1)",
+			value:    types.Int64Value(1),
+			expected: true,
+		},
+		{
+			testName: "value is int64 unknown",
+			value:    types.Int64Unknown(),
+			expected: false,
+		},
+		{
+			testName: "value is int64 null",
+			value:    types.Int64Null(),
+			expected: false,
+		},
+		{
+			testName: "value is bool(false)",
+			value:    types.BoolValue(false),
+			expected: true,
+		},
+	}
+	for _, test := range testTable {
+		t.Run(test.testName, func(t *testing.T) {
+			actual := IsValueProvided(test.value)
+			if actual != test.expected {
+				t.Errorf("Expected %v but got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
 // TODO: Replace ReturnAAPNamedURL with CreateNamedURL during Resource refactor
 func TestReturnAAPNamedURL(t *testing.T) {
 	var testTable = []struct {
@@ -424,3 +462,35 @@ func TestConvertListToInt64Slice(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodeVariables(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    map[string]any
+		expectError bool
+	}{
+		{name: "empty string", input: "", expected: map[string]any{}},
+		{name: "JSON", input: `{"region": "eu", "size": 3}`, expected: map[string]any{"region": "eu", "size": 3}},
+		{name: "YAML", input: "region: eu\nsize: 3\n", expected: map[string]any{"region": "eu", "size": 3}},
+		{name: "not a mapping", input: "- eu\n- us\n", expectError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := DecodeVariables(test.input)
+			if test.expectError {
+				if err == nil {
+					t.Errorf("Expected an error, got %v", result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(test.expected, result) {
+				t.Errorf("Expected %v, but got %v", test.expected, result)
+			}
+		})
+	}
+}
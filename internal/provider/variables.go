@@ -0,0 +1,558 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// variablesMapAttribute returns the schema of the variables_map attribute, an alternative to the
+// variables string attribute accepting native Terraform objects.
+func variablesMapAttribute(entity string) schema.DynamicAttribute {
+	return schema.DynamicAttribute{
+		Optional: true,
+		Description: fmt.Sprintf("Variables for the %s as a Terraform object, for example "+
+			"`{ ansible_port = 22, packages = [\"git\"] }`. The object is sent to AAP as JSON and "+
+			"its keys are compared individually in plans. Conflicts with `variables`.", entity),
+	}
+}
+
+// variablesManagedKeysAttribute returns the schema of the variables_managed_keys attribute.
+func variablesManagedKeysAttribute(entity string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Description: fmt.Sprintf("When set to `true`, only the variables keys present in `variables` or `variables_map` "+
+			"are managed. They are merged into the variables of the %s in AAP, keys set by other automation are left "+
+			"untouched and ignored when detecting changes, and keys removed from the configuration are removed from AAP. "+
+			"The variables are then stored as JSON in AAP.", entity),
+	}
+}
+
+// sensitiveVariablesAttribute returns the schema of the sensitive_variables attribute.
+// sensitive_variables is marked as WriteOnly so that the secrets are sent to the API
+// but never stored in state. The keys are stored in sensitive_variables_keys instead.
+func sensitiveVariablesAttribute(entity string) schema.MapAttribute {
+	return schema.MapAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Sensitive:   true,
+		WriteOnly:   true,
+		Description: fmt.Sprintf("Secret variables for the %s, for example `{ ansible_become_password = var.password }`. "+
+			"They are merged into the variables sent to AAP and ignored when reading the variables back. Their keys "+
+			"must not be set in `variables` or `variables_map`. As changes to the values cannot be detected, change "+
+			"`sensitive_variables_version` to send them again. "+
+			"(Write-only: value is sent to API but not returned in state)", entity),
+	}
+}
+
+// sensitiveVariablesVersionAttribute returns the schema of the sensitive_variables_version attribute.
+func sensitiveVariablesVersionAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional: true,
+		Description: "Version of `sensitive_variables`. Changing it sends the sensitive variables to AAP again, " +
+			"for example after rotating a secret.",
+	}
+}
+
+// sensitiveVariablesKeysAttribute returns the schema of the sensitive_variables_keys attribute.
+func sensitiveVariablesKeysAttribute() schema.SetAttribute {
+	return schema.SetAttribute{
+		ElementType: types.StringType,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.UseStateForUnknown(),
+			sensitiveVariablesKeysModifier{},
+		},
+		Description: "The keys of `sensitive_variables` sent to AAP, which are ignored when reading the variables.",
+	}
+}
+
+// sensitiveVariablesKeysModifier plans the keys of the sensitive variables sent to AAP from the configuration
+// when the resource is created or updated, as they change when the keys of sensitive_variables change.
+type sensitiveVariablesKeysModifier struct{}
+
+// Description returns a plain text description of the modifier's behavior.
+func (m sensitiveVariablesKeysModifier) Description(_ context.Context) string {
+	return "Plans the keys of sensitive_variables when the resource is created or updated."
+}
+
+// MarkdownDescription returns a markdown formatted description of the modifier's behavior.
+func (m sensitiveVariablesKeysModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifySet implements the plan modification logic.
+func (m sensitiveVariablesKeysModifier) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+	// Nothing to do on destroy, or when the resource is not changed and the sensitive variables are not sent
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	// The write-only sensitive variables are only available in the configuration
+	var sensitiveVariables types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sensitive_variables"), &sensitiveVariables)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if sensitiveVariables.IsUnknown() {
+		resp.PlanValue = types.SetUnknown(types.StringType)
+		return
+	}
+	resp.PlanValue = SensitiveVariablesKeys(sensitiveVariables)
+}
+
+// variablesConfigValidators returns the configuration validators ensuring that the variables
+// are provided either as a string or as an object, and that they do not set the sensitive variables.
+func variablesConfigValidators() []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("variables"),
+			path.MatchRoot("variables_map"),
+		),
+		sensitiveVariablesValidator{},
+	}
+}
+
+// sensitiveVariablesValidator validates that the sensitive_variables keys are not also set in the
+// variables, as they would be reported as removed on every read.
+type sensitiveVariablesValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v sensitiveVariablesValidator) Description(_ context.Context) string {
+	return "The sensitive_variables keys must not be set in variables or variables_map."
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v sensitiveVariablesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateResource performs the validation.
+func (v sensitiveVariablesValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse) {
+	var variables customtypes.AAPCustomVariablesValue
+	var variablesMap types.Dynamic
+	var sensitiveVariables types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("variables"), &variables)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("variables_map"), &variablesMap)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sensitive_variables"), &sensitiveVariables)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if variables.IsUnknown() || variablesMap.IsUnknown() || variablesMap.IsUnderlyingValueUnknown() ||
+		sensitiveVariables.IsNull() || sensitiveVariables.IsUnknown() {
+		return
+	}
+
+	configured, diags := RequestVariables(variables, variablesMap)
+	if diags.HasError() {
+		// Invalid variables are reported when the request is created
+		return
+	}
+	data, err := DecodeVariables(configured)
+	if err != nil {
+		return
+	}
+	for key := range sensitiveVariables.Elements() {
+		if _, ok := data[key]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sensitive_variables"),
+				"Invalid sensitive_variables value",
+				fmt.Sprintf("The key %q is set in both the variables and sensitive_variables.", key),
+			)
+		}
+	}
+}
+
+// RequestVariables returns the variables to send to AAP, from either the variables string or
+// the variables_map object.
+func RequestVariables(variables customtypes.AAPCustomVariablesValue, variablesMap types.Dynamic) (string, diag.Diagnostics) {
+	if variablesMap.IsNull() || variablesMap.IsUnderlyingValueNull() {
+		return variables.ValueString(), nil
+	}
+	return VariablesMapToJSON(variablesMap)
+}
+
+// VariablesMapToJSON encodes a variables_map object as a JSON string.
+func VariablesMapToJSON(variablesMap types.Dynamic) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch variablesMap.UnderlyingValue().(type) {
+	case basetypes.ObjectValue, basetypes.MapValue:
+	default:
+		diags.AddAttributeError(
+			path.Root("variables_map"),
+			"Invalid variables_map value",
+			"The variables must be an object, for example { foo = \"bar\" }.",
+		)
+		return "", diags
+	}
+
+	data, err := dynamicToData(variablesMap)
+	if err != nil {
+		diags.AddAttributeError(path.Root("variables_map"), "Invalid variables_map value", err.Error())
+		return "", diags
+	}
+
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		diags.AddError("Error encoding variables_map", err.Error())
+		return "", diags
+	}
+	return string(encoded), diags
+}
+
+// MergeManagedVariables returns the variables to send to AAP when only the configured keys are managed. The
+// configured keys are merged into the variables of the entity in AAP, and the keys that were configured in the
+// prior state but are no longer configured are removed. The prior sensitive variables are removed as well, the
+// configured ones being merged when the request body is created.
+func MergeManagedVariables(client ProviderHTTPClient, url string,
+	priorVariables customtypes.AAPCustomVariablesValue, priorVariablesMap types.Dynamic, priorSensitiveKeys types.Set,
+	variables customtypes.AAPCustomVariablesValue, variablesMap types.Dynamic) (customtypes.AAPCustomVariablesValue, diag.Diagnostics) {
+	prior, diags := RequestVariables(priorVariables, priorVariablesMap)
+	if diags.HasError() {
+		return customtypes.NewAAPCustomVariablesNull(), diags
+	}
+	configured, diags := RequestVariables(variables, variablesMap)
+	if diags.HasError() {
+		return customtypes.NewAAPCustomVariablesNull(), diags
+	}
+
+	body, diags := client.Get(url)
+	if diags.HasError() {
+		return customtypes.NewAAPCustomVariablesNull(), diags
+	}
+	var entity struct {
+		Variables string `json:"variables"`
+	}
+	if err := json.Unmarshal(body, &entity); err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return customtypes.NewAAPCustomVariablesNull(), diags
+	}
+
+	current, diags := RemoveSensitiveVariables(entity.Variables, priorSensitiveKeys)
+	if diags.HasError() {
+		return customtypes.NewAAPCustomVariablesNull(), diags
+	}
+
+	merged, err := mergeVariables(current, prior, configured)
+	if err != nil {
+		diags.AddError("Error merging the variables", err.Error())
+		return customtypes.NewAAPCustomVariablesNull(), diags
+	}
+	return customtypes.NewAAPCustomVariablesValue(merged), diags
+}
+
+// mergeVariables removes the prior keys from the current variables and sets the configured keys.
+func mergeVariables(current, prior, configured string) (string, error) {
+	currentData, err := DecodeVariables(current)
+	if err != nil {
+		return "", err
+	}
+	priorData, err := DecodeVariables(prior)
+	if err != nil {
+		return "", err
+	}
+	configuredData, err := DecodeVariables(configured)
+	if err != nil {
+		return "", err
+	}
+
+	for key := range priorData {
+		delete(currentData, key)
+	}
+	for key, value := range configuredData {
+		currentData[key] = value
+	}
+
+	merged, err := json.Marshal(currentData)
+	if err != nil {
+		return "", err
+	}
+	return string(merged), nil
+}
+
+// FilterManagedVariables returns the variables returned by AAP, restricted to the keys present in the
+// variables or variables_map attribute. An empty string is returned when no variables are configured.
+func FilterManagedVariables(variables string, managedVariables customtypes.AAPCustomVariablesValue,
+	managedVariablesMap types.Dynamic) (string, diag.Diagnostics) {
+	managed, diags := RequestVariables(managedVariables, managedVariablesMap)
+	if diags.HasError() || managed == "" {
+		return "", diags
+	}
+
+	managedData, err := DecodeVariables(managed)
+	if err != nil {
+		diags.AddError("Error parsing the variables", err.Error())
+		return "", diags
+	}
+	data, err := DecodeVariables(variables)
+	if err != nil {
+		diags.AddError("Error parsing the variables returned by AAP", err.Error())
+		return "", diags
+	}
+
+	filtered := make(map[string]any, len(managedData))
+	for key := range managedData {
+		if value, ok := data[key]; ok {
+			filtered[key] = value
+		}
+	}
+	encoded, err := json.Marshal(filtered)
+	if err != nil {
+		diags.AddError("Error encoding the variables", err.Error())
+		return "", diags
+	}
+	return string(encoded), diags
+}
+
+// SensitiveVariablesKeys returns the keys of the sensitive variables, which are stored in state in place of
+// their values.
+func SensitiveVariablesKeys(sensitiveVariables types.Map) types.Set {
+	if sensitiveVariables.IsNull() || sensitiveVariables.IsUnknown() {
+		return types.SetNull(types.StringType)
+	}
+
+	keys := make([]attr.Value, 0, len(sensitiveVariables.Elements()))
+	for key := range sensitiveVariables.Elements() {
+		keys = append(keys, types.StringValue(key))
+	}
+	return types.SetValueMust(types.StringType, keys)
+}
+
+// MergeSensitiveVariables returns the variables to send to AAP with the sensitive variables merged in,
+// encoded as JSON. The variables are returned unchanged when no sensitive variables are set.
+func MergeSensitiveVariables(variables string, sensitiveVariables types.Map) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if sensitiveVariables.IsNull() || sensitiveVariables.IsUnknown() || len(sensitiveVariables.Elements()) == 0 {
+		return variables, diags
+	}
+
+	data, err := DecodeVariables(variables)
+	if err != nil {
+		diags.AddError("Error parsing the variables", err.Error())
+		return "", diags
+	}
+	for key, value := range sensitiveVariables.Elements() {
+		data[key], err = dynamicToData(value)
+		if err != nil {
+			diags.AddAttributeError(path.Root("sensitive_variables"), "Invalid sensitive_variables value", err.Error())
+			return "", diags
+		}
+	}
+
+	merged, err := json.Marshal(data)
+	if err != nil {
+		diags.AddError("Error encoding the variables", err.Error())
+		return "", diags
+	}
+	return string(merged), diags
+}
+
+// RemoveSensitiveVariables returns the variables returned by AAP without the sensitive variables, so that
+// their values are never stored in state. The variables are returned unchanged when there are no sensitive
+// variables in AAP, and an empty string is returned when only sensitive variables are set.
+func RemoveSensitiveVariables(variables string, sensitiveKeys types.Set) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if sensitiveKeys.IsNull() || sensitiveKeys.IsUnknown() || len(sensitiveKeys.Elements()) == 0 {
+		return variables, diags
+	}
+
+	data, err := DecodeVariables(variables)
+	if err != nil {
+		diags.AddError("Error parsing the variables returned by AAP", err.Error())
+		return "", diags
+	}
+	removed := false
+	for _, key := range sensitiveKeys.Elements() {
+		if key, ok := key.(types.String); ok {
+			if _, ok := data[key.ValueString()]; ok {
+				delete(data, key.ValueString())
+				removed = true
+			}
+		}
+	}
+	if !removed {
+		return variables, diags
+	}
+	if len(data) == 0 {
+		return "", diags
+	}
+
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		diags.AddError("Error encoding the variables", err.Error())
+		return "", diags
+	}
+	return string(encoded), diags
+}
+
+// ParseVariables returns the variables and variables_map attribute values from the variables
+// returned by AAP. When the variables are managed through variables_map, the variables attribute
+// is null and variables_map is kept unless the variables were changed outside of Terraform.
+// When only the managed keys are owned, the other keys returned by AAP are ignored.
+func ParseVariables(variables string, current customtypes.AAPCustomVariablesValue, variablesMap types.Dynamic,
+	managedKeys types.Bool) (customtypes.AAPCustomVariablesValue, types.Dynamic, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if managedKeys.ValueBool() {
+		variables, diags = FilterManagedVariables(variables, current, variablesMap)
+		if diags.HasError() {
+			return current, variablesMap, diags
+		}
+	}
+
+	if variablesMap.IsNull() {
+		return ParseAAPCustomVariablesValue(variables), types.DynamicNull(), diags
+	}
+
+	if !variablesMap.IsUnknown() && !variablesMap.IsUnderlyingValueUnknown() {
+		current, currentDiags := VariablesMapToJSON(variablesMap)
+		if !currentDiags.HasError() && customtypes.SemanticallyEqual(current, variables) {
+			return customtypes.NewAAPCustomVariablesNull(), variablesMap, diags
+		}
+	}
+
+	variablesMap, diags = VariablesToMap(variables)
+	return customtypes.NewAAPCustomVariablesNull(), variablesMap, diags
+}
+
+// VariablesToMap decodes the JSON or YAML variables returned by AAP into an object.
+func VariablesToMap(variables string) (types.Dynamic, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, err := DecodeVariables(variables)
+	if err != nil {
+		diags.AddError("Error parsing the variables returned by AAP", err.Error())
+		return types.DynamicNull(), diags
+	}
+	value, _ := dataToValue(data)
+	return types.DynamicValue(value), diags
+}
+
+// dynamicToData converts a Terraform value to data that can be encoded as JSON.
+func dynamicToData(value attr.Value) (any, error) {
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("the value is not known")
+	}
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	var elements []attr.Value
+	switch value := value.(type) {
+	case basetypes.DynamicValue:
+		return dynamicToData(value.UnderlyingValue())
+	case basetypes.ObjectValue:
+		return attributesToData(value.Attributes())
+	case basetypes.MapValue:
+		return attributesToData(value.Elements())
+	case basetypes.ListValue:
+		elements = value.Elements()
+	case basetypes.SetValue:
+		elements = value.Elements()
+	case basetypes.TupleValue:
+		elements = value.Elements()
+	case basetypes.StringValue:
+		return value.ValueString(), nil
+	case basetypes.BoolValue:
+		return value.ValueBool(), nil
+	case basetypes.NumberValue:
+		return numberToData(value.ValueBigFloat()), nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", value)
+	}
+
+	data := make([]any, 0, len(elements))
+	for _, element := range elements {
+		elementData, err := dynamicToData(element)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, elementData)
+	}
+	return data, nil
+}
+
+// numberToData converts a Terraform number to a JSON number, without exponent for integers.
+func numberToData(number *big.Float) json.Number {
+	if number.IsInt() {
+		integer, _ := number.Int(nil)
+		return json.Number(integer.String())
+	}
+	return json.Number(number.Text('g', -1))
+}
+
+// attributesToData converts the attributes of a Terraform object or map to data that can be encoded as JSON.
+func attributesToData(attributes map[string]attr.Value) (map[string]any, error) {
+	data := make(map[string]any, len(attributes))
+	for key, attribute := range attributes {
+		attributeData, err := dynamicToData(attribute)
+		if err != nil {
+			return nil, err
+		}
+		data[key] = attributeData
+	}
+	return data, nil
+}
+
+// dataToValue converts data decoded from JSON or YAML to a Terraform value and its type. Mappings
+// are converted to objects and sequences to tuples, so that their elements may have different types.
+func dataToValue(data any) (attr.Value, attr.Type) {
+	switch data := data.(type) {
+	case map[string]any:
+		attributes := make(map[string]attr.Value, len(data))
+		attributeTypes := make(map[string]attr.Type, len(data))
+		for key, value := range data {
+			attributes[key], attributeTypes[key] = dataToValue(value)
+		}
+		return types.ObjectValueMust(attributeTypes, attributes), types.ObjectType{AttrTypes: attributeTypes}
+	case map[any]any:
+		attributes := make(map[string]attr.Value, len(data))
+		attributeTypes := make(map[string]attr.Type, len(data))
+		for key, value := range data {
+			attributes[fmt.Sprint(key)], attributeTypes[fmt.Sprint(key)] = dataToValue(value)
+		}
+		return types.ObjectValueMust(attributeTypes, attributes), types.ObjectType{AttrTypes: attributeTypes}
+	case []any:
+		elements := make([]attr.Value, len(data))
+		elementTypes := make([]attr.Type, len(data))
+		for i, value := range data {
+			elements[i], elementTypes[i] = dataToValue(value)
+		}
+		return types.TupleValueMust(elementTypes, elements), types.TupleType{ElemTypes: elementTypes}
+	case string:
+		return types.StringValue(data), types.StringType
+	case bool:
+		return types.BoolValue(data), types.BoolType
+	case int, int64, uint64:
+		number, _ := new(big.Float).SetString(fmt.Sprint(data))
+		return types.NumberValue(number), types.NumberType
+	case float64:
+		// Infinite and NaN values, which YAML allows, cannot be represented as Terraform numbers
+		if math.IsInf(data, 0) || math.IsNaN(data) {
+			return types.StringValue(fmt.Sprint(data)), types.StringType
+		}
+		return types.NumberValue(big.NewFloat(data)), types.NumberType
+	case nil:
+		return types.StringNull(), types.StringType
+	default:
+		return types.StringValue(fmt.Sprint(data)), types.StringType
+	}
+}
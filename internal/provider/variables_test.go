@@ -0,0 +1,595 @@
+package provider
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"go.uber.org/mock/gomock"
+)
+
+// testVariablesMap returns the variables_map value of {port = 22, name = "web", tags = ["a", true], extra = null}.
+func testVariablesMap(port int64) types.Dynamic {
+	return types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{
+			"port":  types.NumberType,
+			"name":  types.StringType,
+			"tags":  types.TupleType{ElemTypes: []attr.Type{types.StringType, types.BoolType}},
+			"extra": types.StringType,
+		},
+		map[string]attr.Value{
+			"port": types.NumberValue(big.NewFloat(float64(port))),
+			"name": types.StringValue("web"),
+			"tags": types.TupleValueMust(
+				[]attr.Type{types.StringType, types.BoolType},
+				[]attr.Value{types.StringValue("a"), types.BoolValue(true)},
+			),
+			"extra": types.StringNull(),
+		},
+	))
+}
+
+func TestRequestVariables(t *testing.T) {
+	var testTable = []struct {
+		name         string
+		variables    customtypes.AAPCustomVariablesValue
+		variablesMap types.Dynamic
+		expected     string
+		expectError  bool
+	}{
+		{
+			name:         "variables string",
+			variables:    customtypes.NewAAPCustomVariablesValue("foo: bar"),
+			variablesMap: types.DynamicNull(),
+			expected:     "foo: bar",
+		},
+		{
+			name:         "no variables",
+			variables:    customtypes.NewAAPCustomVariablesNull(),
+			variablesMap: types.DynamicNull(),
+			expected:     "",
+		},
+		{
+			name:         "variables object",
+			variables:    customtypes.NewAAPCustomVariablesNull(),
+			variablesMap: testVariablesMap(22),
+			expected:     `{"extra":null,"name":"web","port":22,"tags":["a",true]}`,
+		},
+		{
+			name:      "variables map with decimal number",
+			variables: customtypes.NewAAPCustomVariablesNull(),
+			variablesMap: types.DynamicValue(types.MapValueMust(types.NumberType, map[string]attr.Value{
+				"ratio": types.NumberValue(big.NewFloat(0.5)),
+			})),
+			expected: `{"ratio":0.5}`,
+		},
+		{
+			name:         "variables not an object",
+			variables:    customtypes.NewAAPCustomVariablesNull(),
+			variablesMap: types.DynamicValue(types.StringValue("foo")),
+			expectError:  true,
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := RequestVariables(test.variables, test.variablesMap)
+			if diags.HasError() != test.expectError {
+				t.Fatalf("Expected error %t, got diagnostics (%s)", test.expectError, diags)
+			}
+			if actual != test.expected {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestParseVariables(t *testing.T) {
+	t.Run("variables string", func(t *testing.T) {
+		variables, variablesMap, diags := ParseVariables("foo: bar", customtypes.NewAAPCustomVariablesNull(), types.DynamicNull(), types.BoolNull())
+		if diags.HasError() {
+			t.Fatal(diags.Errors())
+		}
+		if !variables.Equal(customtypes.NewAAPCustomVariablesValue("foo: bar")) {
+			t.Errorf("Expected variables (foo: bar), got (%s)", variables.ValueString())
+		}
+		if !variablesMap.IsNull() {
+			t.Errorf("Expected null variables_map, got (%s)", variablesMap)
+		}
+	})
+
+	t.Run("unchanged variables object is kept", func(t *testing.T) {
+		prior := testVariablesMap(22)
+		variables, variablesMap, diags := ParseVariables("---\ntags: [a, true]\nport: 22.0\nname: web\nextra: null\n",
+			customtypes.NewAAPCustomVariablesNull(), prior, types.BoolNull())
+		if diags.HasError() {
+			t.Fatal(diags.Errors())
+		}
+		if !variables.IsNull() {
+			t.Errorf("Expected null variables, got (%s)", variables.ValueString())
+		}
+		if !variablesMap.Equal(prior) {
+			t.Errorf("Expected (%s) not equal to actual (%s)", prior, variablesMap)
+		}
+	})
+
+	t.Run("changed variables object is refreshed", func(t *testing.T) {
+		variables, variablesMap, diags := ParseVariables(`{"port":2222,"os":"Linux"}`,
+			customtypes.NewAAPCustomVariablesNull(), testVariablesMap(22), types.BoolNull())
+		if diags.HasError() {
+			t.Fatal(diags.Errors())
+		}
+		if !variables.IsNull() {
+			t.Errorf("Expected null variables, got (%s)", variables.ValueString())
+		}
+		expected := types.DynamicValue(types.ObjectValueMust(
+			map[string]attr.Type{"port": types.NumberType, "os": types.StringType},
+			map[string]attr.Value{"port": types.NumberValue(big.NewFloat(2222)), "os": types.StringValue("Linux")},
+		))
+		if !variablesMap.Equal(expected) {
+			t.Errorf("Expected (%s) not equal to actual (%s)", expected, variablesMap)
+		}
+	})
+
+	t.Run("invalid variables", func(t *testing.T) {
+		_, _, diags := ParseVariables("foo: [bar", customtypes.NewAAPCustomVariablesNull(), testVariablesMap(22), types.BoolNull())
+		if !diags.HasError() {
+			t.Error("Expected an error parsing invalid variables")
+		}
+	})
+}
+
+func TestVariablesToMap(t *testing.T) {
+	variablesMap, diags := VariablesToMap("nested:\n  list: [1, {key: value}]\nempty:\n")
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+
+	encoded, diags := VariablesMapToJSON(variablesMap)
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+	expected := `{"empty":null,"nested":{"list":[1,{"key":"value"}]}}`
+	if encoded != expected {
+		t.Errorf("Expected (%s) not equal to actual (%s)", expected, encoded)
+	}
+}
+
+func TestMergeManagedVariables(t *testing.T) {
+	t.Run("configured keys are merged and removed keys deleted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := NewMockProviderHTTPClient(ctrl)
+		mockClient.EXPECT().Get("/api/v2/hosts/1/").Return(
+			[]byte(`{"id":1,"variables":"foo: bar\nold: true\nfacts_cache: 1\n"}`),
+			diag.Diagnostics{},
+		)
+
+		merged, diags := MergeManagedVariables(mockClient, "/api/v2/hosts/1/",
+			customtypes.NewAAPCustomVariablesValue(`{"foo":"bar","old":true}`), types.DynamicNull(), types.SetNull(types.StringType),
+			customtypes.NewAAPCustomVariablesValue("foo: qux\nnew: [1, 2]"), types.DynamicNull())
+		if diags.HasError() {
+			t.Fatal(diags.Errors())
+		}
+		expected := `{"facts_cache":1,"foo":"qux","new":[1,2]}`
+		if merged.ValueString() != expected {
+			t.Errorf("Expected (%s) not equal to actual (%s)", expected, merged.ValueString())
+		}
+	})
+
+	t.Run("variables object", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := NewMockProviderHTTPClient(ctrl)
+		mockClient.EXPECT().Get("/api/v2/groups/1/").Return([]byte(`{"id":1,"variables":""}`), diag.Diagnostics{})
+
+		merged, diags := MergeManagedVariables(mockClient, "/api/v2/groups/1/",
+			customtypes.NewAAPCustomVariablesNull(), types.DynamicNull(), types.SetNull(types.StringType),
+			customtypes.NewAAPCustomVariablesNull(), testVariablesMap(22))
+		if diags.HasError() {
+			t.Fatal(diags.Errors())
+		}
+		expected := `{"extra":null,"name":"web","port":22,"tags":["a",true]}`
+		if merged.ValueString() != expected {
+			t.Errorf("Expected (%s) not equal to actual (%s)", expected, merged.ValueString())
+		}
+	})
+
+	t.Run("prior sensitive variables are removed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := NewMockProviderHTTPClient(ctrl)
+		mockClient.EXPECT().Get("/api/v2/hosts/1/").Return(
+			[]byte(`{"id":1,"variables":"{\"foo\":\"bar\",\"password\":\"secret\",\"other\":1}"}`),
+			diag.Diagnostics{},
+		)
+
+		merged, diags := MergeManagedVariables(mockClient, "/api/v2/hosts/1/",
+			customtypes.NewAAPCustomVariablesValue(`{"foo":"bar"}`), types.DynamicNull(),
+			types.SetValueMust(types.StringType, []attr.Value{types.StringValue("password")}),
+			customtypes.NewAAPCustomVariablesValue(`{"foo":"baz"}`), types.DynamicNull())
+		if diags.HasError() {
+			t.Fatal(diags.Errors())
+		}
+		expected := `{"foo":"baz","other":1}`
+		if merged.ValueString() != expected {
+			t.Errorf("Expected (%s) not equal to actual (%s)", expected, merged.ValueString())
+		}
+	})
+
+	t.Run("returns request errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		errorDiags := diag.Diagnostics{}
+		errorDiags.AddError("Client request error", "not found")
+
+		mockClient := NewMockProviderHTTPClient(ctrl)
+		mockClient.EXPECT().Get("/api/v2/hosts/1/").Return(nil, errorDiags)
+
+		_, diags := MergeManagedVariables(mockClient, "/api/v2/hosts/1/",
+			customtypes.NewAAPCustomVariablesNull(), types.DynamicNull(), types.SetNull(types.StringType),
+			customtypes.NewAAPCustomVariablesValue("foo: bar"), types.DynamicNull())
+		if !diags.Equal(errorDiags) {
+			t.Errorf("Expected error diagnostics (%s), actual was (%s)", errorDiags, diags)
+		}
+	})
+}
+
+func TestFilterManagedVariables(t *testing.T) {
+	var testTable = []struct {
+		name         string
+		variables    string
+		managed      customtypes.AAPCustomVariablesValue
+		managedMap   types.Dynamic
+		expected     string
+		expectErrors bool
+	}{
+		{
+			name:       "unmanaged keys are ignored",
+			variables:  `{"foo":"bar","facts_cache":1}`,
+			managed:    customtypes.NewAAPCustomVariablesValue("foo: baz"),
+			managedMap: types.DynamicNull(),
+			expected:   `{"foo":"bar"}`,
+		},
+		{
+			name:       "missing managed keys are omitted",
+			variables:  "facts_cache: 1",
+			managed:    customtypes.NewAAPCustomVariablesValue(`{"foo":"bar"}`),
+			managedMap: types.DynamicNull(),
+			expected:   `{}`,
+		},
+		{
+			name:       "keys of the variables object",
+			variables:  `{"port":22,"name":"web","other":true}`,
+			managed:    customtypes.NewAAPCustomVariablesNull(),
+			managedMap: testVariablesMap(22),
+			expected:   `{"name":"web","port":22}`,
+		},
+		{
+			name:       "no configured variables",
+			variables:  `{"foo":"bar"}`,
+			managed:    customtypes.NewAAPCustomVariablesNull(),
+			managedMap: types.DynamicNull(),
+			expected:   "",
+		},
+		{
+			name:         "invalid variables",
+			variables:    "foo: [bar",
+			managed:      customtypes.NewAAPCustomVariablesValue("foo: bar"),
+			managedMap:   types.DynamicNull(),
+			expectErrors: true,
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := FilterManagedVariables(test.variables, test.managed, test.managedMap)
+			if diags.HasError() != test.expectErrors {
+				t.Fatalf("Expected error %t, got diagnostics (%s)", test.expectErrors, diags)
+			}
+			if actual != test.expected {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestParseVariablesManagedKeys(t *testing.T) {
+	t.Run("variables string", func(t *testing.T) {
+		current := customtypes.NewAAPCustomVariablesValue("foo: bar")
+		variables, variablesMap, diags := ParseVariables(`{"foo":"bar","facts_cache":1}`, current,
+			types.DynamicNull(), types.BoolValue(true))
+		if diags.HasError() {
+			t.Fatal(diags.Errors())
+		}
+		if !customtypes.SemanticallyEqual(current.ValueString(), variables.ValueString()) {
+			t.Errorf("Expected variables semantically equal to (%s), got (%s)", current.ValueString(), variables.ValueString())
+		}
+		if !variablesMap.IsNull() {
+			t.Errorf("Expected null variables_map, got (%s)", variablesMap)
+		}
+	})
+
+	t.Run("no variables", func(t *testing.T) {
+		variables, _, diags := ParseVariables(`{"facts_cache":1}`, customtypes.NewAAPCustomVariablesNull(),
+			types.DynamicNull(), types.BoolValue(true))
+		if diags.HasError() {
+			t.Fatal(diags.Errors())
+		}
+		if !variables.IsNull() {
+			t.Errorf("Expected null variables, got (%s)", variables.ValueString())
+		}
+	})
+
+	t.Run("variables object", func(t *testing.T) {
+		prior := testVariablesMap(22)
+		_, variablesMap, diags := ParseVariables(`{"port":22,"name":"web","tags":["a",true],"extra":null,"facts_cache":1}`,
+			customtypes.NewAAPCustomVariablesNull(), prior, types.BoolValue(true))
+		if diags.HasError() {
+			t.Fatal(diags.Errors())
+		}
+		if !variablesMap.Equal(prior) {
+			t.Errorf("Expected (%s) not equal to actual (%s)", prior, variablesMap)
+		}
+	})
+}
+
+// testSensitiveVariables returns the sensitive_variables value of the provided keys and values.
+func testSensitiveVariables(keysAndValues ...string) types.Map {
+	elements := map[string]attr.Value{}
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		elements[keysAndValues[i]] = types.StringValue(keysAndValues[i+1])
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+func TestSensitiveVariablesKeys(t *testing.T) {
+	var testTable = []struct {
+		name      string
+		sensitive types.Map
+		expected  types.Set
+	}{
+		{
+			name:      "null",
+			sensitive: types.MapNull(types.StringType),
+			expected:  types.SetNull(types.StringType),
+		},
+		{
+			name:      "empty",
+			sensitive: testSensitiveVariables(),
+			expected:  types.SetValueMust(types.StringType, []attr.Value{}),
+		},
+		{
+			name:      "keys",
+			sensitive: testSensitiveVariables("password", "secret", "token", "abc"),
+			expected: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("password"),
+				types.StringValue("token"),
+			}),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual := SensitiveVariablesKeys(test.sensitive)
+			if !actual.Equal(test.expected) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMergeSensitiveVariables(t *testing.T) {
+	var testTable = []struct {
+		name         string
+		variables    string
+		sensitive    types.Map
+		expected     string
+		expectErrors bool
+	}{
+		{
+			name:      "no sensitive variables",
+			variables: "foo: bar",
+			sensitive: types.MapNull(types.StringType),
+			expected:  "foo: bar",
+		},
+		{
+			name:      "empty sensitive variables",
+			variables: "foo: bar",
+			sensitive: testSensitiveVariables(),
+			expected:  "foo: bar",
+		},
+		{
+			name:      "merged into YAML variables",
+			variables: "foo: bar\nport: 22",
+			sensitive: testSensitiveVariables("ansible_become_password", "secret"),
+			expected:  `{"ansible_become_password":"secret","foo":"bar","port":22}`,
+		},
+		{
+			name:      "no variables",
+			variables: "",
+			sensitive: testSensitiveVariables("password", "secret"),
+			expected:  `{"password":"secret"}`,
+		},
+		{
+			name:         "invalid variables",
+			variables:    "foo: [bar",
+			sensitive:    testSensitiveVariables("password", "secret"),
+			expectErrors: true,
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := MergeSensitiveVariables(test.variables, test.sensitive)
+			if diags.HasError() != test.expectErrors {
+				t.Fatalf("Expected error %t, got diagnostics (%s)", test.expectErrors, diags)
+			}
+			if actual != test.expected {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRemoveSensitiveVariables(t *testing.T) {
+	passwordKey := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("password")})
+
+	var testTable = []struct {
+		name         string
+		variables    string
+		keys         types.Set
+		expected     string
+		expectErrors bool
+	}{
+		{
+			name:      "no sensitive keys",
+			variables: "foo: bar\npassword: secret",
+			keys:      types.SetNull(types.StringType),
+			expected:  "foo: bar\npassword: secret",
+		},
+		{
+			name:      "sensitive keys are removed",
+			variables: `{"foo":"bar","password":"secret"}`,
+			keys:      passwordKey,
+			expected:  `{"foo":"bar"}`,
+		},
+		{
+			name:      "variables are unchanged without sensitive keys in AAP",
+			variables: "foo: bar",
+			keys:      passwordKey,
+			expected:  "foo: bar",
+		},
+		{
+			name:      "only sensitive variables",
+			variables: `{"password":"secret"}`,
+			keys:      passwordKey,
+			expected:  "",
+		},
+		{
+			name:         "invalid variables",
+			variables:    "foo: [bar",
+			keys:         passwordKey,
+			expectErrors: true,
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := RemoveSensitiveVariables(test.variables, test.keys)
+			if diags.HasError() != test.expectErrors {
+				t.Fatalf("Expected error %t, got diagnostics (%s)", test.expectErrors, diags)
+			}
+			if actual != test.expected {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSensitiveVariablesKeysModifier(t *testing.T) {
+	ctx := t.Context()
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":                     schema.StringAttribute{Optional: true},
+			"sensitive_variables":      sensitiveVariablesAttribute("host"),
+			"sensitive_variables_keys": sensitiveVariablesKeysAttribute(),
+		},
+	}
+	objectType := testSchema.Type().TerraformType(ctx)
+	keysType := tftypes.Set{ElementType: tftypes.String}
+	sensitiveType := tftypes.Map{ElementType: tftypes.String}
+	newValue := func(name string, sensitive tftypes.Value, keys tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name":                     tftypes.NewValue(tftypes.String, name),
+			"sensitive_variables":      sensitive,
+			"sensitive_variables_keys": keys,
+		})
+	}
+	nullSensitive := tftypes.NewValue(sensitiveType, nil)
+	sensitive := tftypes.NewValue(sensitiveType, map[string]tftypes.Value{"password": tftypes.NewValue(tftypes.String, "secret")})
+	unknownSensitive := tftypes.NewValue(sensitiveType, tftypes.UnknownValue)
+	priorKeys := tftypes.NewValue(keysType, []tftypes.Value{tftypes.NewValue(tftypes.String, "token")})
+	unknownKeys := tftypes.NewValue(keysType, tftypes.UnknownValue)
+	priorState := newValue("web", nullSensitive, priorKeys)
+
+	var testTable = []struct {
+		name     string
+		state    tftypes.Value
+		plan     tftypes.Value
+		config   tftypes.Value
+		expected types.Set
+	}{
+		{
+			name:     "create",
+			state:    tftypes.NewValue(objectType, nil),
+			plan:     newValue("web", nullSensitive, unknownKeys),
+			config:   newValue("web", sensitive, tftypes.NewValue(keysType, nil)),
+			expected: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("password")}),
+		},
+		{
+			name:     "update",
+			state:    priorState,
+			plan:     newValue("db", nullSensitive, unknownKeys),
+			config:   newValue("db", sensitive, tftypes.NewValue(keysType, nil)),
+			expected: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("password")}),
+		},
+		{
+			name:     "update without sensitive variables",
+			state:    priorState,
+			plan:     newValue("db", nullSensitive, unknownKeys),
+			config:   newValue("db", nullSensitive, tftypes.NewValue(keysType, nil)),
+			expected: types.SetNull(types.StringType),
+		},
+		{
+			name:     "update with unknown sensitive variables",
+			state:    priorState,
+			plan:     newValue("db", nullSensitive, unknownKeys),
+			config:   newValue("db", unknownSensitive, tftypes.NewValue(keysType, nil)),
+			expected: types.SetUnknown(types.StringType),
+		},
+		{
+			name:     "no change",
+			state:    priorState,
+			plan:     priorState,
+			config:   newValue("web", sensitive, tftypes.NewValue(keysType, nil)),
+			expected: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("token")}),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			req := planmodifier.SetRequest{
+				Path:   path.Root("sensitive_variables_keys"),
+				Config: tfsdk.Config{Schema: testSchema, Raw: test.config},
+				Plan:   tfsdk.Plan{Schema: testSchema, Raw: test.plan},
+				State:  tfsdk.State{Schema: testSchema, Raw: test.state},
+			}
+			var planValue types.Set
+			if diags := req.Plan.GetAttribute(ctx, req.Path, &planValue); diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			resp := &planmodifier.SetResponse{PlanValue: planValue}
+
+			sensitiveVariablesKeysModifier{}.PlanModifySet(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics.Errors())
+			}
+			if !resp.PlanValue.Equal(test.expected) {
+				t.Errorf("Expected (%v), got (%v)", test.expected, resp.PlanValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,324 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	// workflowApprovalApprove approves the pending workflow approvals.
+	workflowApprovalApprove = "approve"
+	// workflowApprovalDeny denies the pending workflow approvals.
+	workflowApprovalDeny = "deny"
+	// workflowApprovalsPageSize is the page size used to retrieve the pending workflow approvals.
+	workflowApprovalsPageSize = 200
+)
+
+// workflowApprovalDecisions are the accepted values of the decision attribute, mapped to the reported outcome.
+var workflowApprovalDecisions = map[string]string{
+	workflowApprovalApprove: "approved",
+	workflowApprovalDeny:    "denied",
+}
+
+// WorkflowApprovalAPIModel represents the AAP API model of a workflow approval.
+// /api/controller/v2/workflow_approvals/
+type WorkflowApprovalAPIModel struct {
+	ID            int64  `json:"id"`
+	Name          string `json:"name"`
+	Status        string `json:"status"`
+	URL           string `json:"url"`
+	SummaryFields struct {
+		SourceWorkflowJob SummaryField `json:"source_workflow_job"`
+	} `json:"summary_fields"`
+}
+
+// WorkflowApprovalAction represents an action approving or denying the pending approvals of workflow jobs in AAP.
+type WorkflowApprovalAction struct {
+	client ProviderHTTPClient
+}
+
+func NewWorkflowApprovalAction() action.Action {
+	return &WorkflowApprovalAction{}
+}
+
+var (
+	_ action.Action                     = (*WorkflowApprovalAction)(nil)
+	_ action.ActionWithConfigValidators = (*WorkflowApprovalAction)(nil)
+	_ action.ActionWithValidateConfig   = (*WorkflowApprovalAction)(nil)
+)
+
+type WorkflowApprovalActionModel struct {
+	WorkflowJobID            types.Int64  `tfsdk:"workflow_job_id"`
+	AllWorkflowJobs          types.Bool   `tfsdk:"all_workflow_jobs"`
+	ApprovalName             types.String `tfsdk:"approval_name"`
+	Decision                 types.String `tfsdk:"decision"`
+	WaitForCompletion        types.Bool   `tfsdk:"wait_for_completion"`
+	WaitForCompletionTimeout types.Int64  `tfsdk:"wait_for_completion_timeout_seconds"`
+	IgnoreJobResults         types.Bool   `tfsdk:"ignore_job_results"`
+}
+
+// Schema defines the schema for the workflow approval action
+func (a *WorkflowApprovalAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workflow_job_id": schema.Int64Attribute{
+				Optional: true,
+				Description: "ID of the workflow job whose pending approvals are decided. " +
+					"Required unless `all_workflow_jobs` is `true`.",
+			},
+			"all_workflow_jobs": schema.BoolAttribute{
+				Optional: true,
+				Description: "When this is set to `true`, the pending approvals of all the workflow jobs are decided, " +
+					"restricted to the approval nodes named `approval_name` when it is provided. " +
+					"Must be `true` when `workflow_job_id` is not provided.",
+			},
+			"approval_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the approval nodes whose pending approvals are decided.",
+			},
+			"decision": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(workflowApprovalApprove, workflowApprovalDeny),
+				},
+				Description: "Whether to `approve` or `deny` the pending approvals.",
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+				Description: "When this is set to `true`, Terraform will wait until the workflow jobs of the decided " +
+					"approvals resume and reach any final status and then, proceeds with the following resource operation",
+			},
+			"wait_for_completion_timeout_seconds": schema.Int64Attribute{
+				Optional: true,
+				Description: "Sets the maximum amount of seconds Terraform will wait for each workflow job " +
+					"to reach a final status, and for the pending approvals to be found. Default value of `120`",
+			},
+			"ignore_job_results": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "When this is set to `true`, and wait_for_completion is `true`, ignore the workflow job status.",
+			},
+		},
+		MarkdownDescription: "Approves or denies pending AAP workflow approvals.\n\n" +
+			"This action decides the pending approvals of a workflow job, optionally only the ones of the approval nodes " +
+			"with a given name, such as to progress a workflow job launched with `aap_workflow_job_launch`. " +
+			"Deciding the pending approvals of all the workflow jobs requires opting in with `all_workflow_jobs`. " +
+			"The action waits for the approvals to be pending, as a workflow job may not have reached them yet. \n" +
+			"Moreover, you can set `wait_for_completion` to true, then Terraform will " +
+			"wait until the workflow jobs resume and reach any final state before continuing. " +
+			"You can also tweak `wait_for_completion_timeout_seconds` to control the timeout limit.",
+	}
+}
+
+// ConfigValidators returns the configuration validators of the workflow approval action.
+func (a *WorkflowApprovalAction) ConfigValidators(_ context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionConfigValidator{resourcevalidator.ExactlyOneOf(
+			tfpath.MatchRoot("workflow_job_id"),
+			tfpath.MatchRoot("all_workflow_jobs"),
+		)},
+	}
+}
+
+// ValidateConfig checks that all_workflow_jobs is only set to opt in to deciding the approvals of all the workflow jobs.
+func (a *WorkflowApprovalAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config WorkflowApprovalActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.AllWorkflowJobs.IsNull() || config.AllWorkflowJobs.IsUnknown() {
+		return
+	}
+
+	if !config.AllWorkflowJobs.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			tfpath.Root("all_workflow_jobs"),
+			"Invalid Attribute Value",
+			"all_workflow_jobs must be true to decide the pending approvals of all the workflow jobs, "+
+				"provide workflow_job_id instead to decide the ones of a single workflow job.",
+		)
+	}
+}
+
+// GetPendingWorkflowApprovals returns the pending workflow approvals of the given workflow job, or of all the
+// workflow jobs when the workflow job ID is zero, restricted to the ones with the given name when it is not empty.
+func GetPendingWorkflowApprovals(
+	ctx context.Context,
+	client ProviderHTTPClient,
+	workflowJobID int64,
+	approvalName string,
+) ([]WorkflowApprovalAPIModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var approvals []WorkflowApprovalAPIModel
+
+	params := map[string]string{
+		"status":    "pending",
+		"order_by":  "id",
+		"page_size": strconv.Itoa(workflowApprovalsPageSize),
+	}
+	if workflowJobID != 0 {
+		// The approvals are the jobs of the nodes of their workflow job
+		params["unified_job_node__workflow_job"] = strconv.FormatInt(workflowJobID, 10)
+	}
+	if approvalName != "" {
+		params["name"] = approvalName
+	}
+
+	approvalsURL := path.Join(client.getAPIEndpoint(), "workflow_approvals")
+	for page := 1; ; page++ {
+		if !IsContextActive(ctx, "GetPendingWorkflowApprovals", &diags) {
+			return nil, diags
+		}
+
+		params["page"] = strconv.Itoa(page)
+		body, pageDiags := client.GetWithParams(approvalsURL, params)
+		diags.Append(pageDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var approvalsPage struct {
+			Next    string                     `json:"next"`
+			Results []WorkflowApprovalAPIModel `json:"results"`
+		}
+		err := json.Unmarshal(body, &approvalsPage)
+		if err != nil {
+			diags.AddError("Error parsing JSON response from AAP", err.Error())
+			return nil, diags
+		}
+		approvals = append(approvals, approvalsPage.Results...)
+
+		if approvalsPage.Next == "" {
+			return approvals, diags
+		}
+	}
+}
+
+// DecideWorkflowApproval approves or denies the workflow approval at the given URL.
+func DecideWorkflowApproval(client ProviderHTTPClient, approvalURL string, decision string) diag.Diagnostics {
+	resp, body, err := client.doRequest(http.MethodPost, path.Join(approvalURL, decision), nil, nil)
+	return ValidateResponse(resp, body, err, []int{http.StatusNoContent})
+}
+
+// Invoke executes the workflow approval action.
+func (a *WorkflowApprovalAction) Invoke(ctx context.Context, req action.InvokeRequest, response *action.InvokeResponse) {
+	var config WorkflowApprovalActionModel
+
+	response.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set default timeout if not provided
+	if config.WaitForCompletionTimeout.IsNull() {
+		config.WaitForCompletionTimeout = types.Int64Value(waitForCompletionTimeoutDefault)
+	}
+
+	// The approval nodes of a workflow job just launched may not be pending yet
+	timeout := time.Duration(config.WaitForCompletionTimeout.ValueInt64()) * time.Second
+	var approvals []WorkflowApprovalAPIModel
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		var diags diag.Diagnostics
+		approvals, diags = GetPendingWorkflowApprovals(ctx, a.client, config.WorkflowJobID.ValueInt64(), config.ApprovalName.ValueString())
+		if diags.HasError() {
+			return retry.NonRetryableError(fmt.Errorf("error fetching the pending workflow approvals: %s", diags.Errors()))
+		}
+		if len(approvals) == 0 {
+			return retry.RetryableError(fmt.Errorf("no pending workflow approvals were found for workflow job ID %d and approval name %q",
+				config.WorkflowJobID.ValueInt64(), config.ApprovalName.ValueString()))
+		}
+		return nil
+	})
+	if err != nil {
+		response.Diagnostics.Append(diag.NewErrorDiagnostic("No pending workflow approvals", err.Error()))
+		return
+	}
+
+	decision := config.Decision.ValueString()
+	var workflowJobIDs []int64
+	for _, approval := range approvals {
+		response.Diagnostics.Append(DecideWorkflowApproval(a.client, approval.URL, decision)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		workflowJob := approval.SummaryFields.SourceWorkflowJob
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Workflow approval %s (%d) %s, workflow job ID: %d",
+				approval.Name, approval.ID, workflowApprovalDecisions[decision], workflowJob.ID),
+		})
+		tflog.Debug(ctx, "workflow approval decided", map[string]interface{}{
+			"url":             approval.URL,
+			"decision":        decision,
+			"workflow_job_id": workflowJob.ID,
+		})
+
+		if !slices.Contains(workflowJobIDs, workflowJob.ID) {
+			workflowJobIDs = append(workflowJobIDs, workflowJob.ID)
+		}
+	}
+
+	if !config.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	for _, workflowJobID := range workflowJobIDs {
+		workflowJobURL := path.Join(a.client.getAPIEndpoint(), "workflow_jobs", strconv.FormatInt(workflowJobID, 10))
+		var status string
+		retryProgressFunc := func(status string) {
+			response.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Workflow job at: %s is in status: %s", workflowJobURL, status),
+			})
+		}
+		err := retry.RetryContext(ctx, timeout, retryUntilAAPJobReachesAnyFinalState(ctx, a.client, retryProgressFunc, workflowJobURL, &status))
+		if err != nil {
+			response.Diagnostics.Append(diag.NewErrorDiagnostic("error when waiting for AAP workflow job to complete", err.Error()))
+			return
+		}
+
+		workflowJob := WorkflowJobResourceModel{
+			URL:              types.StringValue(workflowJobURL),
+			Status:           types.StringValue(status),
+			IgnoreJobResults: config.IgnoreJobResults,
+		}
+		response.Diagnostics.Append(workflowJob.CheckWorkflowJobResults(ctx, a.client)...)
+	}
+}
+
+// Configure configures the workflow approval action with the provider client
+func (a *WorkflowApprovalAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+// Metadata returns the action metadata
+func (a *WorkflowApprovalAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_approval"
+}
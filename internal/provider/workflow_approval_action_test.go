@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+
+	fwaction "github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.uber.org/mock/gomock"
+)
+
+// TestWorkflowApprovalActionSchema tests the Schema function
+func TestWorkflowApprovalActionSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	schemaResponse := fwaction.SchemaResponse{}
+	NewWorkflowApprovalAction().Schema(ctx, fwaction.SchemaRequest{}, &schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+// TestWorkflowApprovalActionMetadata tests the Metadata function
+func TestWorkflowApprovalActionMetadata(t *testing.T) {
+	t.Parallel()
+
+	metadataResponse := fwaction.MetadataResponse{}
+	NewWorkflowApprovalAction().Metadata(t.Context(), fwaction.MetadataRequest{ProviderTypeName: "aap"}, &metadataResponse)
+
+	expected := "aap_workflow_approval"
+	if metadataResponse.TypeName != expected {
+		t.Errorf("Expected metadata TypeName %q, received %q", expected, metadataResponse.TypeName)
+	}
+}
+
+// workflowApprovalActionConfig returns the configuration of the workflow approval action with the given values.
+func workflowApprovalActionConfig(t *testing.T, workflowJobID *int64, allWorkflowJobs *bool, approvalName *string,
+	decision string, wait *bool, timeout *int64, ignoreJobResults *bool) tfsdk.Config {
+	ctx := t.Context()
+	schemaResp := fwaction.SchemaResponse{}
+	NewWorkflowApprovalAction().Schema(ctx, fwaction.SchemaRequest{}, &schemaResp)
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"workflow_job_id":                     valueOrNil(tftypes.Number, workflowJobID),
+			"all_workflow_jobs":                   valueOrNil(tftypes.Bool, allWorkflowJobs),
+			"approval_name":                       valueOrNil(tftypes.String, approvalName),
+			"decision":                            valueOrNil(tftypes.String, &decision),
+			"wait_for_completion":                 valueOrNil(tftypes.Bool, wait),
+			"wait_for_completion_timeout_seconds": valueOrNil(tftypes.Number, timeout),
+			"ignore_job_results":                  valueOrNil(tftypes.Bool, ignoreJobResults),
+		}),
+	}
+}
+
+func TestWorkflowApprovalActionConfigValidators(t *testing.T) {
+	workflowJobID := int64(12)
+	approvalName := "Production sign-off"
+	allTrue := true
+	allFalse := false
+
+	var testTable = []struct {
+		name            string
+		workflowJobID   *int64
+		allWorkflowJobs *bool
+		approvalName    *string
+		expectError     bool
+	}{
+		{name: "workflow job id", workflowJobID: &workflowJobID},
+		{name: "workflow job id and approval name", workflowJobID: &workflowJobID, approvalName: &approvalName},
+		{name: "all workflow jobs", allWorkflowJobs: &allTrue},
+		{name: "all workflow jobs and approval name", allWorkflowJobs: &allTrue, approvalName: &approvalName},
+		{name: "approval name only", approvalName: &approvalName, expectError: true},
+		{name: "all workflow jobs not opted in", allWorkflowJobs: &allFalse, approvalName: &approvalName, expectError: true},
+		{name: "workflow job id and all workflow jobs", workflowJobID: &workflowJobID, allWorkflowJobs: &allTrue, expectError: true},
+		{name: "no workflow job id nor all workflow jobs", expectError: true},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := t.Context()
+			config := workflowApprovalActionConfig(t, test.workflowJobID, test.allWorkflowJobs, test.approvalName,
+				workflowApprovalApprove, nil, nil, nil)
+
+			var diags diag.Diagnostics
+			action := NewWorkflowApprovalAction().(*WorkflowApprovalAction)
+			for _, validator := range action.ConfigValidators(ctx) {
+				resp := &fwaction.ValidateConfigResponse{}
+				validator.ValidateAction(ctx, fwaction.ValidateConfigRequest{Config: config}, resp)
+				diags.Append(resp.Diagnostics...)
+			}
+			resp := &fwaction.ValidateConfigResponse{}
+			action.ValidateConfig(ctx, fwaction.ValidateConfigRequest{Config: config}, resp)
+			diags.Append(resp.Diagnostics...)
+			if test.expectError != diags.HasError() {
+				t.Errorf("Expected error (%t), got (%v)", test.expectError, diags.Errors())
+			}
+		})
+	}
+}
+
+// pendingWorkflowApprovalsParams returns the query parameters of the given page of the pending workflow approvals.
+func pendingWorkflowApprovalsParams(page string, workflowJobID string, approvalName string) map[string]string {
+	params := map[string]string{"status": "pending", "order_by": "id", "page_size": "200", "page": page}
+	if workflowJobID != "" {
+		params["unified_job_node__workflow_job"] = workflowJobID
+	}
+	if approvalName != "" {
+		params["name"] = approvalName
+	}
+	return params
+}
+
+func TestGetPendingWorkflowApprovals(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockProviderHTTPClient(ctrl)
+	mockClient.EXPECT().getAPIEndpoint().Return("/api/v2")
+	gomock.InOrder(
+		mockClient.EXPECT().GetWithParams("/api/v2/workflow_approvals", pendingWorkflowApprovalsParams("1", "12", "Sign-off")).Return(
+			[]byte(`{"next": "/api/v2/workflow_approvals/?page=2", "results": [`+
+				`{"id": 20, "name": "Sign-off", "url": "/api/v2/workflow_approvals/20/", "summary_fields": {"source_workflow_job": {"id": 12}}}]}`),
+			diag.Diagnostics{},
+		),
+		mockClient.EXPECT().GetWithParams("/api/v2/workflow_approvals", pendingWorkflowApprovalsParams("2", "12", "Sign-off")).Return(
+			[]byte(`{"next": null, "results": [`+
+				`{"id": 22, "name": "Sign-off", "url": "/api/v2/workflow_approvals/22/", "summary_fields": {"source_workflow_job": {"id": 12}}}]}`),
+			diag.Diagnostics{},
+		),
+	)
+
+	approvals, diags := GetPendingWorkflowApprovals(t.Context(), mockClient, 12, "Sign-off")
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+
+	var approvalIDs []int64
+	for _, approval := range approvals {
+		approvalIDs = append(approvalIDs, approval.ID)
+	}
+	if !reflect.DeepEqual([]int64{20, 22}, approvalIDs) {
+		t.Errorf("Expected approvals [20 22], got %v", approvalIDs)
+	}
+}
+
+// mockWorkflowApprovalDecision mocks the decision on the workflow approval with the given ID.
+func mockWorkflowApprovalDecision(mock *MockProviderHTTPClient, approvalID int64, decision string) {
+	mock.EXPECT().doRequest(http.MethodPost, fmt.Sprintf("/api/v2/workflow_approvals/%d/%s", approvalID, decision), nil, nil).
+		Return(&http.Response{StatusCode: http.StatusNoContent}, []byte{}, nil)
+}
+
+// TestWorkflowApprovalActionInvoke tests the full Invoke function
+func TestWorkflowApprovalActionInvoke(t *testing.T) {
+	workflowJobID := int64(12)
+	approvalName := "Sign-off"
+	allTrue := true
+	waitTrue := true
+	timeout := int64(1)
+	workflowJobApprovals := []byte(`{"next": null, "results": [` +
+		`{"id": 20, "name": "Sign-off", "url": "/api/v2/workflow_approvals/20/", "summary_fields": {"source_workflow_job": {"id": 12}}}]}`)
+	pendingApprovals := []byte(`{"next": null, "results": [` +
+		`{"id": 20, "name": "Sign-off", "url": "/api/v2/workflow_approvals/20/", "summary_fields": {"source_workflow_job": {"id": 12}}},` +
+		`{"id": 21, "name": "Sign-off", "url": "/api/v2/workflow_approvals/21/", "summary_fields": {"source_workflow_job": {"id": 13}}}]}`)
+
+	testTable := []struct {
+		name             string
+		workflowJobID    *int64
+		allWorkflowJobs  *bool
+		approvalName     *string
+		decision         string
+		wait             *bool
+		setupMock        func(*MockProviderHTTPClient)
+		expectedProgress []string
+		expectError      bool
+	}{
+		{
+			name:          "approve the approvals of a workflow job",
+			workflowJobID: &workflowJobID,
+			decision:      workflowApprovalApprove,
+			setupMock: func(mock *MockProviderHTTPClient) {
+				mock.EXPECT().GetWithParams("/api/v2/workflow_approvals", pendingWorkflowApprovalsParams("1", "12", "")).
+					Return(workflowJobApprovals, diag.Diagnostics{})
+				mockWorkflowApprovalDecision(mock, 20, workflowApprovalApprove)
+			},
+			expectedProgress: []string{"Workflow approval Sign-off (20) approved, workflow job ID: 12"},
+		},
+		{
+			name:            "deny the approvals of all the workflow jobs by name and wait",
+			allWorkflowJobs: &allTrue,
+			approvalName:    &approvalName,
+			decision:        workflowApprovalDeny,
+			wait:            &waitTrue,
+			setupMock: func(mock *MockProviderHTTPClient) {
+				mock.EXPECT().GetWithParams("/api/v2/workflow_approvals", pendingWorkflowApprovalsParams("1", "", approvalName)).
+					Return(pendingApprovals, diag.Diagnostics{})
+				mockWorkflowApprovalDecision(mock, 20, workflowApprovalDeny)
+				mockWorkflowApprovalDecision(mock, 21, workflowApprovalDeny)
+				mock.EXPECT().Get("/api/v2/workflow_jobs/12").Return([]byte(`{"status": "successful"}`), diag.Diagnostics{})
+				mock.EXPECT().Get("/api/v2/workflow_jobs/13").Return([]byte(`{"status": "failed"}`), diag.Diagnostics{})
+				mock.EXPECT().GetWithParams("/api/v2/workflow_jobs/13/workflow_nodes", map[string]string{
+					"job__failed": "true", "order_by": "id", "page": "1", "page_size": "200",
+				}).
+					Return([]byte(`{"results": []}`), diag.Diagnostics{})
+			},
+			expectedProgress: []string{
+				"Workflow approval Sign-off (20) denied, workflow job ID: 12",
+				"Workflow approval Sign-off (21) denied, workflow job ID: 13",
+				"Workflow job at: /api/v2/workflow_jobs/12 is in status: successful",
+				"Workflow job at: /api/v2/workflow_jobs/13 is in status: failed",
+			},
+			expectError: true,
+		},
+		{
+			name:          "no pending approvals",
+			workflowJobID: &workflowJobID,
+			decision:      workflowApprovalApprove,
+			setupMock: func(mock *MockProviderHTTPClient) {
+				mock.EXPECT().GetWithParams("/api/v2/workflow_approvals", pendingWorkflowApprovalsParams("1", "12", "")).
+					Return([]byte(`{"next": null, "results": []}`), diag.Diagnostics{}).MinTimes(1)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockProviderHTTPClient(ctrl)
+			mockClient.EXPECT().getAPIEndpoint().Return("/api/v2").AnyTimes()
+			tc.setupMock(mockClient)
+
+			var progress []string
+			resp := &fwaction.InvokeResponse{
+				SendProgress: func(event fwaction.InvokeProgressEvent) {
+					progress = append(progress, event.Message)
+				},
+			}
+			action := &WorkflowApprovalAction{client: mockClient}
+			config := workflowApprovalActionConfig(t, tc.workflowJobID, tc.allWorkflowJobs, tc.approvalName, tc.decision, tc.wait, &timeout, nil)
+			action.Invoke(t.Context(), fwaction.InvokeRequest{Config: config}, resp)
+
+			if tc.expectError != resp.Diagnostics.HasError() {
+				t.Errorf("Expected error (%t), got (%v)", tc.expectError, resp.Diagnostics.Errors())
+			}
+			if !reflect.DeepEqual(tc.expectedProgress, progress) {
+				t.Errorf("Expected progress (%v), got (%v)", tc.expectedProgress, progress)
+			}
+		})
+	}
+}
+
+func TestAccAAPWorkflowApprovalAction_basic(t *testing.T) {
+	workflowJobTemplateID := os.Getenv("AAP_TEST_WORKFLOW_JOB_TEMPLATE_APPROVAL_ID")
+	if workflowJobTemplateID == "" {
+		t.Skip("AAP_TEST_WORKFLOW_JOB_TEMPLATE_APPROVAL_ID environment variable not set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowApprovalAction(workflowJobTemplateID, workflowApprovalApprove),
+			},
+			{
+				Config:      testAccWorkflowApprovalAction(workflowJobTemplateID, workflowApprovalDeny),
+				ExpectError: regexp.MustCompile(".*AAP workflow job failed.*"),
+			},
+		},
+	})
+}
+
+func testAccWorkflowApprovalAction(workflowJobTemplateID string, decision string) string {
+	return fmt.Sprintf(`
+resource "aap_workflow_job" "test" {
+	workflow_job_template_id = %s
+	triggers = {
+		"decision" = "%s"
+	}
+	lifecycle {
+		action_trigger {
+			events  = [after_create, after_update]
+			actions = [action.aap_workflow_approval.test]
+		}
+	}
+}
+
+action "aap_workflow_approval" "test" {
+	config {
+		workflow_job_id     = tonumber(regex("[0-9]+", aap_workflow_job.test.url))
+		decision            = "%s"
+		wait_for_completion = true
+	}
+}
+`, workflowJobTemplateID, decision, decision)
+}
@@ -7,9 +7,12 @@ import (
 	"time"
 
 	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/action/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -25,7 +28,8 @@ func NewWorkflowJobAction() action.Action {
 }
 
 var (
-	_ action.Action = (*WorkflowJobAction)(nil)
+	_ action.Action                     = (*WorkflowJobAction)(nil)
+	_ action.ActionWithConfigValidators = (*WorkflowJobAction)(nil)
 )
 
 type WorkflowJobActionModel struct {
@@ -43,19 +47,95 @@ func (a *WorkflowJobAction) Schema(_ context.Context, _ action.SchemaRequest, re
 					"If not provided, the job will be created in the default inventory.",
 			},
 			"workflow_job_template_id": schema.Int64Attribute{
-				Required:    true,
-				Description: "Id of the workflow job template.",
+				Optional: true,
+				Description: "Id of the workflow job template. Required unless `workflow_job_template_name` and " +
+					"`organization_name` are provided.",
+			},
+			"workflow_job_template_name": schema.StringAttribute{
+				Optional: true,
+				Description: "Name of the workflow job template, to reference it together with `organization_name` instead of " +
+					"`workflow_job_template_id`.",
+			},
+			"organization_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the organization of the workflow job template referenced by `workflow_job_template_name`.",
 			},
 			"extra_vars": schema.StringAttribute{
 				Description: "Extra Variables. Must be provided as either a JSON or YAML string.",
 				Optional:    true,
+				CustomType:  customtypes.AAPCustomVariablesType{},
+			},
+			"limit": schema.StringAttribute{
+				Description: "Limit pattern to restrict the jobs of the workflow to specific hosts.",
+				Optional:    true,
+				CustomType:  customtypes.AAPCustomStringType{},
+			},
+			"job_tags": schema.StringAttribute{
+				Description: "Tags to include in the jobs of the workflow.",
+				Optional:    true,
 				CustomType:  customtypes.AAPCustomStringType{},
 			},
+			"skip_tags": schema.StringAttribute{
+				Description: "Tags to skip in the jobs of the workflow.",
+				Optional:    true,
+				CustomType:  customtypes.AAPCustomStringType{},
+			},
+			"diff_mode": schema.BoolAttribute{
+				Description: "Enable diff mode for the jobs of the workflow.",
+				Optional:    true,
+			},
+			"verbosity": schema.Int64Attribute{
+				Description: "Verbosity level for the jobs of the workflow. Valid values: 0 (Normal), 1 (Verbose), 2 (More Verbose), 3 (Debug), 4 (Connection Debug), 5 (WinRM Debug).",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, VerbosityMax),
+				},
+			},
+			"execution_environment": schema.Int64Attribute{
+				Description: "ID of the execution environment to use for the jobs of the workflow.",
+				Optional:    true,
+			},
+			"forks": schema.Int64Attribute{
+				Description: "Number of parallel processes to use for the jobs of the workflow.",
+				Optional:    true,
+			},
+			"job_slice_count": schema.Int64Attribute{
+				Description: "Number of slices to divide the jobs of the workflow into.",
+				Optional:    true,
+			},
+			"timeout": schema.Int64Attribute{
+				Description: "Timeout in seconds for the jobs of the workflow.",
+				Optional:    true,
+			},
+			"instance_groups": schema.ListAttribute{
+				Description: "List of instance group IDs to use for the jobs of the workflow.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+			},
+			"credentials": schema.ListAttribute{
+				Description: "List of credential IDs to use for the jobs of the workflow. (Value is sent to API but not returned in state)",
+				Optional:    true,
+				ElementType: types.Int64Type,
+			},
+			"labels": schema.ListAttribute{
+				Description: "List of label IDs to apply to the workflow job. (Value is sent to API but not returned in state)",
+				Optional:    true,
+				ElementType: types.Int64Type,
+			},
 			"wait_for_completion": schema.BoolAttribute{
 				Optional: true,
 				Description: "When this is set to `true`, Terraform will wait until this aap_job resource is created, reaches " +
 					"any final status and then, proceeds with the following resource operation",
 			},
+			"stream_job_events": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(jobEventsFilters...),
+				},
+				Description: "When `wait_for_completion` is `true`, reports the output of the jobs of the workflow as progress while it runs: " +
+					"`tasks` for the play and task names and the play recap, `failures` for the failed tasks only, " +
+					"or `stdout` for the full output.",
+			},
 			"wait_for_completion_timeout_seconds": schema.Int64Attribute{
 				Optional: true,
 				Description: "Sets the maximum amount of seconds Terraform will wait before timing out the updates, " +
@@ -73,6 +153,11 @@ func (a *WorkflowJobAction) Schema(_ context.Context, _ action.SchemaRequest, re
 	}
 }
 
+// ConfigValidators returns the configuration validators of the workflow job action.
+func (a *WorkflowJobAction) ConfigValidators(_ context.Context) []action.ConfigValidator {
+	return templateActionConfigValidators("workflow_job_template_id", "workflow_job_template_name")
+}
+
 // Invoke executes the job action.
 func (a *WorkflowJobAction) Invoke(ctx context.Context, req action.InvokeRequest, response *action.InvokeResponse) {
 	var config WorkflowJobActionModel
@@ -116,12 +201,19 @@ func (a *WorkflowJobAction) Invoke(ctx context.Context, req action.InvokeRequest
 		}
 		timeout := time.Duration(config.WaitForCompletionTimeout.ValueInt64()) * time.Second
 		var status string
+		streamer := newWorkflowJobEventsStreamer(a.client, jobResponse.URL, config.StreamJobEvents)
+		sendJobEvent := func(message string) {
+			response.SendProgress(action.InvokeProgressEvent{Message: message})
+		}
 		retryProgressFunc := func(status string) {
 			response.SendProgress(action.InvokeProgressEvent{
 				Message: fmt.Sprintf("Workflow job at: %s is in status: %s", jobResponse.URL, status),
 			})
+			StreamJobEvents(ctx, streamer, sendJobEvent)
 		}
 		err := retry.RetryContext(ctx, timeout, retryUntilAAPJobReachesAnyFinalState(ctx, a.client, retryProgressFunc, jobResponse.URL, &status))
+		// Events can still be recorded once the workflow job reached a final state
+		StreamJobEvents(ctx, streamer, sendJobEvent)
 		if err != nil {
 			response.Diagnostics.Append(diag.NewErrorDiagnostic("error when waiting for AAP job to complete", err.Error()))
 			return
@@ -7,9 +7,14 @@ import (
 	"fmt"
 	"net/http"
 	"path"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -35,11 +41,26 @@ type WorkflowJobAPIModel struct {
 }
 
 type WorkflowJobModel struct {
-	TemplateID               types.Int64                      `tfsdk:"workflow_job_template_id"`
-	InventoryID              types.Int64                      `tfsdk:"inventory_id"`
-	ExtraVars                customtypes.AAPCustomStringValue `tfsdk:"extra_vars"`
-	WaitForCompletion        types.Bool                       `tfsdk:"wait_for_completion"`
-	WaitForCompletionTimeout types.Int64                      `tfsdk:"wait_for_completion_timeout_seconds"`
+	TemplateID               types.Int64                         `tfsdk:"workflow_job_template_id"`
+	TemplateName             types.String                        `tfsdk:"workflow_job_template_name"`
+	OrganizationName         types.String                        `tfsdk:"organization_name"`
+	InventoryID              types.Int64                         `tfsdk:"inventory_id"`
+	ExtraVars                customtypes.AAPCustomVariablesValue `tfsdk:"extra_vars"`
+	WaitForCompletion        types.Bool                          `tfsdk:"wait_for_completion"`
+	WaitForCompletionTimeout types.Int64                         `tfsdk:"wait_for_completion_timeout_seconds"`
+	StreamJobEvents          types.String                        `tfsdk:"stream_job_events"`
+	Limit                    customtypes.AAPCustomStringValue    `tfsdk:"limit"`
+	JobTags                  customtypes.AAPCustomStringValue    `tfsdk:"job_tags"`
+	SkipTags                 customtypes.AAPCustomStringValue    `tfsdk:"skip_tags"`
+	DiffMode                 types.Bool                          `tfsdk:"diff_mode"`
+	Verbosity                types.Int64                         `tfsdk:"verbosity"`
+	ExecutionEnvironmentID   types.Int64                         `tfsdk:"execution_environment"`
+	Forks                    types.Int64                         `tfsdk:"forks"`
+	JobSliceCount            types.Int64                         `tfsdk:"job_slice_count"`
+	Timeout                  types.Int64                         `tfsdk:"timeout"`
+	InstanceGroups           types.List                          `tfsdk:"instance_groups"`
+	Credentials              types.List                          `tfsdk:"credentials"`
+	Labels                   types.List                          `tfsdk:"labels"`
 }
 
 // WorkflowJobResourceModel maps the resource schema data.
@@ -50,6 +71,29 @@ type WorkflowJobResourceModel struct {
 	Status        types.String `tfsdk:"status"`
 	IgnoredFields types.List   `tfsdk:"ignored_fields"`
 	Triggers      types.Map    `tfsdk:"triggers"`
+	// IgnoreJobResults only applies to the resource, the action has its own attribute.
+	IgnoreJobResults types.Bool `tfsdk:"ignore_job_results"`
+	CancelOnDestroy  types.Bool `tfsdk:"cancel_on_destroy"`
+	CancelOnTimeout  types.Bool `tfsdk:"cancel_on_timeout"`
+}
+
+// WorkflowJobNodeListAPIModel represents a page of the workflow job nodes.
+// /api/controller/v2/workflow_jobs/<id>/workflow_nodes/
+type WorkflowJobNodeListAPIModel struct {
+	Next    string `json:"next"`
+	Results []struct {
+		SummaryFields struct {
+			Job WorkflowJobNodeJobAPIModel `json:"job"`
+		} `json:"summary_fields"`
+	} `json:"results"`
+}
+
+// WorkflowJobNodeJobAPIModel represents the summary of the job of a workflow job node.
+type WorkflowJobNodeJobAPIModel struct {
+	ID     int64  `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Type   string `json:"type"`
 }
 
 // WorkflowJobResource is the resource implementation.
@@ -59,8 +103,10 @@ type WorkflowJobResource struct {
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &WorkflowJobResource{}
-	_ resource.ResourceWithConfigure = &WorkflowJobResource{}
+	_ resource.Resource                     = &WorkflowJobResource{}
+	_ resource.ResourceWithConfigure        = &WorkflowJobResource{}
+	_ resource.ResourceWithModifyPlan       = &WorkflowJobResource{}
+	_ resource.ResourceWithConfigValidators = &WorkflowJobResource{}
 )
 
 // NewWorkflowJobResource is a helper function to simplify the provider implementation.
@@ -106,8 +152,19 @@ func (r *WorkflowJobResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "Identifier for the inventory the job will be run against.",
 			},
 			"workflow_job_template_id": schema.Int64Attribute{
-				Required:    true,
-				Description: "ID of the workflow job template.",
+				Optional: true,
+				Computed: true,
+				Description: "ID of the workflow job template. Required unless `workflow_job_template_name` and " +
+					"`organization_name` are provided.",
+			},
+			"workflow_job_template_name": schema.StringAttribute{
+				Optional: true,
+				Description: "Name of the workflow job template, to reference it together with `organization_name` instead of " +
+					"`workflow_job_template_id`. The ID of the workflow job template is resolved when the workflow job is launched.",
+			},
+			"organization_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the organization of the workflow job template referenced by `workflow_job_template_name`.",
 			},
 			"job_type": schema.StringAttribute{
 				Computed:    true,
@@ -118,13 +175,16 @@ func (r *WorkflowJobResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "URL of the workflow job template",
 			},
 			"status": schema.StringAttribute{
-				Computed:    true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					RelaunchUnsuccessfulJob(),
+				},
 				Description: "Status of the workflow job",
 			},
 			"extra_vars": schema.StringAttribute{
 				Description: "Extra Variables. Must be provided as either a JSON or YAML string.",
 				Optional:    true,
-				CustomType:  customtypes.AAPCustomStringType{},
+				CustomType:  customtypes.AAPCustomVariablesType{},
 			},
 			"triggers": schema.MapAttribute{
 				Optional:    true,
@@ -138,6 +198,67 @@ func (r *WorkflowJobResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed:    true,
 				Description: "The list of properties set by the user but ignored on server side.",
 			},
+			"limit": schema.StringAttribute{
+				Description: "Limit pattern to restrict the jobs of the workflow to specific hosts.",
+				Optional:    true,
+				CustomType:  customtypes.AAPCustomStringType{},
+			},
+			"job_tags": schema.StringAttribute{
+				Description: "Tags to include in the jobs of the workflow.",
+				Optional:    true,
+				CustomType:  customtypes.AAPCustomStringType{},
+			},
+			"skip_tags": schema.StringAttribute{
+				Description: "Tags to skip in the jobs of the workflow.",
+				Optional:    true,
+				CustomType:  customtypes.AAPCustomStringType{},
+			},
+			"diff_mode": schema.BoolAttribute{
+				Description: "Enable diff mode for the jobs of the workflow.",
+				Optional:    true,
+			},
+			"verbosity": schema.Int64Attribute{
+				Description: "Verbosity level for the jobs of the workflow. Valid values: 0 (Normal), 1 (Verbose), 2 (More Verbose), 3 (Debug), 4 (Connection Debug), 5 (WinRM Debug).",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, VerbosityMax),
+				},
+			},
+			"execution_environment": schema.Int64Attribute{
+				Description: "ID of the execution environment to use for the jobs of the workflow.",
+				Optional:    true,
+			},
+			"forks": schema.Int64Attribute{
+				Description: "Number of parallel processes to use for the jobs of the workflow.",
+				Optional:    true,
+			},
+			"job_slice_count": schema.Int64Attribute{
+				Description: "Number of slices to divide the jobs of the workflow into.",
+				Optional:    true,
+			},
+			"timeout": schema.Int64Attribute{
+				Description: "Timeout in seconds for the jobs of the workflow.",
+				Optional:    true,
+			},
+			"instance_groups": schema.ListAttribute{
+				Description: "List of instance group IDs to use for the jobs of the workflow.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+			},
+			// credentials and labels are marked as WriteOnly because the values are sent to the API
+			// at workflow job launch time but are not returned from the workflow job GET endpoint.
+			"credentials": schema.ListAttribute{
+				Description: "List of credential IDs to use for the jobs of the workflow. (Write-only: value is sent to API but not returned in state)",
+				Optional:    true,
+				WriteOnly:   true,
+				ElementType: types.Int64Type,
+			},
+			"labels": schema.ListAttribute{
+				Description: "List of label IDs to apply to the workflow job. (Write-only: value is sent to API but not returned in state)",
+				Optional:    true,
+				WriteOnly:   true,
+				ElementType: types.Int64Type,
+			},
 			"wait_for_completion": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
@@ -145,6 +266,15 @@ func (r *WorkflowJobResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "When this is set to `true`, Terraform will wait until this aap_job resource is created, reaches " +
 					"any final status and then, proceeds with the following resource operation",
 			},
+			"stream_job_events": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(jobEventsFilters...),
+				},
+				Description: "When `wait_for_completion` is `true`, logs the output of the jobs of the workflow while it runs: " +
+					"`tasks` for the play and task names and the play recap, `failures` for the failed tasks only, " +
+					"or `stdout` for the full output. The output is logged at the INFO level, see `TF_LOG`.",
+			},
 			"wait_for_completion_timeout_seconds": schema.Int64Attribute{
 				Optional: true,
 				Computed: true,
@@ -152,6 +282,26 @@ func (r *WorkflowJobResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "Sets the maximum amount of seconds Terraform will wait before timing out the updates, " +
 					"and the job creation will fail. Default value of `120`",
 			},
+			"ignore_job_results": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "When this is set to `true`, and `wait_for_completion` is `true`, a workflow job that does not " +
+					"succeed only raises a warning. Otherwise the apply fails and the workflow job is launched again on the next apply.",
+			},
+			"cancel_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When this is set to `true`, destroying this resource cancels the workflow job if it is still running.",
+			},
+			"cancel_on_timeout": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "When this is set to `true`, and `wait_for_completion` is `true`, the workflow job is canceled " +
+					"when `wait_for_completion_timeout_seconds` expires. Otherwise the workflow job keeps running in AAP.",
+			},
 		},
 		MarkdownDescription: "Launches an AAP workflow job.\n\n" +
 			"A workflow job is launched only when the resource is first created or when the " +
@@ -159,7 +309,9 @@ func (r *WorkflowJobResource) Schema(_ context.Context, _ resource.SchemaRequest
 			"launch a new workflow job based on any arbitrary value.\n\n" +
 			"This resource always creates a new workflow job in AAP. A destroy will not " +
 			"delete a workflow job created by this resource, it will only remove the resource " +
-			"from the state.",
+			"from the state, and cancel the workflow job if it is still running and `cancel_on_destroy` is `true`.\n\n" +
+			"When `wait_for_completion` is `true` and the workflow job does not succeed, the apply fails " +
+			"and the workflow job is launched again on the next apply, unless `ignore_job_results` is `true`.",
 	}
 }
 
@@ -173,6 +325,16 @@ func (r *WorkflowJobResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	// WriteOnly attributes (credentials, labels) must be read from the config,
+	// not the plan, because WriteOnly values are always null in the plan.
+	var configData WorkflowJobResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Credentials = configData.Credentials
+	data.Labels = configData.Labels
+
 	resp.Diagnostics.Append(data.LaunchWorkflowJobWithResponse(r.client)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -183,15 +345,25 @@ func (r *WorkflowJobResource) Create(ctx context.Context, req resource.CreateReq
 	if data.WaitForCompletion.ValueBool() {
 		timeout := time.Duration(data.WaitForCompletionTimeout.ValueInt64()) * time.Second
 		var status string
+		streamer := newWorkflowJobEventsStreamer(r.client, data.URL.ValueString(), data.StreamJobEvents)
+		logJobEvent := func(message string) {
+			tflog.Info(ctx, message, map[string]interface{}{"url": data.URL.ValueString()})
+		}
 		retryProgressFunc := func(status string) {
 			tflog.Debug(ctx, "Job status update", map[string]interface{}{
 				"status": status,
 				"url":    data.URL.ValueString(),
 			})
+			StreamJobEvents(ctx, streamer, logJobEvent)
 		}
 		err := retry.RetryContext(ctx, timeout, retryUntilAAPJobReachesAnyFinalState(ctx, r.client, retryProgressFunc, data.URL.ValueString(), &status))
+		// Events can still be recorded once the job reached a final state
+		StreamJobEvents(ctx, streamer, logJobEvent)
 		if err != nil {
 			resp.Diagnostics.AddError("error when waiting for AAP Workflow job to complete", err.Error())
+			if data.CancelOnTimeout.ValueBool() {
+				resp.Diagnostics.Append(CancelAAPJob(ctx, r.client, data.URL.ValueString(), timeout)...)
+			}
 			return
 		}
 		data.Status = types.StringValue(status)
@@ -202,6 +374,51 @@ func (r *WorkflowJobResource) Create(ctx context.Context, req resource.CreateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// The state is saved before reporting an unsuccessful workflow job, so the next apply launches it again
+	if data.WaitForCompletion.ValueBool() {
+		resp.Diagnostics.Append(data.CheckWorkflowJobResults(ctx, r.client)...)
+	}
+}
+
+// ConfigValidators returns the configuration validators of the resource.
+func (r *WorkflowJobResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	// You have either a template id or a template name + organization_name pair
+	return templateConfigValidators("workflow_job_template_id", "workflow_job_template_name")
+}
+
+// ModifyPlan validates the launch prompts against the workflow job template when a new workflow job
+// is planned, so that missing or ignored prompts are reported before any other resource is changed.
+func (r *WorkflowJobResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, before the provider is configured or when no workflow job is launched
+	if req.Plan.Raw.IsNull() || r.client == nil || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	// The prompts are read from the plan: omitted prompts, such as inventory_id or limit, are unknown
+	// in the plan and the template defaults are used for them.
+	var data WorkflowJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// A template referenced by name is resolved here only to validate the prompts,
+	// its ID is recorded in the state when the job is launched
+	resp.Diagnostics.Append(data.ResolveTemplateID(r.client)...)
+	if resp.Diagnostics.HasError() || !IsValueProvided(data.TemplateID) {
+		return
+	}
+
+	// WriteOnly attributes (credentials, labels) are always null in the plan
+	var configData WorkflowJobResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Credentials = configData.Credentials
+	data.Labels = configData.Labels
+
+	resp.Diagnostics.Append(data.CanWorkflowJobBeLaunched(r.client)...)
 }
 
 func (r *WorkflowJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -255,6 +472,16 @@ func (r *WorkflowJobResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	// WriteOnly attributes (credentials, labels) must be read from the config,
+	// not the plan, because WriteOnly values are always null in the plan.
+	var configData WorkflowJobResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Credentials = configData.Credentials
+	data.Labels = configData.Labels
+
 	// Create new Workflow Job from workflow job template
 	resp.Diagnostics.Append(data.LaunchWorkflowJobWithResponse(r.client)...)
 	if resp.Diagnostics.HasError() {
@@ -266,15 +493,25 @@ func (r *WorkflowJobResource) Update(ctx context.Context, req resource.UpdateReq
 	if data.WaitForCompletion.ValueBool() {
 		timeout := time.Duration(data.WaitForCompletionTimeout.ValueInt64()) * time.Second
 		var status string
+		streamer := newWorkflowJobEventsStreamer(r.client, data.URL.ValueString(), data.StreamJobEvents)
+		logJobEvent := func(message string) {
+			tflog.Info(ctx, message, map[string]interface{}{"url": data.URL.ValueString()})
+		}
 		retryProgressFunc := func(status string) {
 			tflog.Debug(ctx, "Job status update", map[string]interface{}{
 				"status": status,
 				"url":    data.URL.ValueString(),
 			})
+			StreamJobEvents(ctx, streamer, logJobEvent)
 		}
 		err := retry.RetryContext(ctx, timeout, retryUntilAAPJobReachesAnyFinalState(ctx, r.client, retryProgressFunc, data.URL.ValueString(), &status))
+		// Events can still be recorded once the job reached a final state
+		StreamJobEvents(ctx, streamer, logJobEvent)
 		if err != nil {
 			resp.Diagnostics.AddError("error when waiting for AAP Workflow job to complete", err.Error())
+			if data.CancelOnTimeout.ValueBool() {
+				resp.Diagnostics.Append(CancelAAPJob(ctx, r.client, data.URL.ValueString(), timeout)...)
+			}
 			return
 		}
 		data.Status = types.StringValue(status)
@@ -285,25 +522,50 @@ func (r *WorkflowJobResource) Update(ctx context.Context, req resource.UpdateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// The state is saved before reporting an unsuccessful workflow job, so the next apply launches it again
+	if data.WaitForCompletion.ValueBool() {
+		resp.Diagnostics.Append(data.CheckWorkflowJobResults(ctx, r.client)...)
+	}
 }
 
-// Delete is intentionally left blank Job and Workflow Job Resources.
-// Current guidance is to manage this inside AAP.
-func (r WorkflowJobResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+// Delete does not delete the workflow job, current guidance is to manage this inside AAP.
+// The workflow job is canceled if it is still running and cancel_on_destroy is set.
+func (r WorkflowJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WorkflowJobResourceModel
+
+	// Read current Terraform state data into workflow job resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.CancelOnDestroy.ValueBool() {
+		timeout := time.Duration(data.WaitForCompletionTimeout.ValueInt64()) * time.Second
+		resp.Diagnostics.Append(CancelRunningAAPJob(ctx, r.client, data.URL.ValueString(), timeout)...)
+	}
 }
 
 // CreateRequestBody creates a JSON encoded request body from the workflow job resource data
 func (r *WorkflowJobModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	// Convert workflow job resource data to API data model
-	workflowJob := WorkflowJobAPIModel{
-		ExtraVars: r.ExtraVars.ValueString(),
-	}
-
-	// Set inventory id if provided
-	if r.InventoryID.ValueInt64() != 0 {
-		workflowJob.Inventory = r.InventoryID.ValueInt64()
+	// The launch endpoints of job templates and workflow job templates accept the same fields
+	workflowJob := JobLaunchRequestModel{
+		Inventory:            r.InventoryID.ValueInt64(),
+		ExtraVars:            r.ExtraVars.ValueString(),
+		Limit:                r.Limit.ValueString(),
+		JobTags:              r.JobTags.ValueString(),
+		SkipTags:             r.SkipTags.ValueString(),
+		DiffMode:             r.DiffMode.ValueBool(),
+		Verbosity:            r.Verbosity.ValueInt64(),
+		ExecutionEnvironment: r.ExecutionEnvironmentID.ValueInt64(),
+		Forks:                r.Forks.ValueInt64(),
+		JobSliceCount:        r.JobSliceCount.ValueInt64(),
+		Timeout:              r.Timeout.ValueInt64(),
+		InstanceGroups:       ConvertListToInt64Slice(r.InstanceGroups),
+		Credentials:          ConvertListToInt64Slice(r.Credentials),
+		Labels:               ConvertListToInt64Slice(r.Labels),
 	}
 
 	// Create JSON encoded request body
@@ -360,9 +622,35 @@ func (r *WorkflowJobResourceModel) ParseIgnoredFields(ignoredFields map[string]i
 	return diags
 }
 
+// ResolveTemplateID sets the ID of the Workflow Job Template referenced by workflow_job_template_name
+// and organization_name. Nothing is done when the ID is already known or the name is not.
+func (r *WorkflowJobModel) ResolveTemplateID(client ProviderHTTPClient) diag.Diagnostics {
+	if IsValueProvided(r.TemplateID) || !IsValueProvided(r.TemplateName) || !IsValueProvided(r.OrganizationName) {
+		return nil
+	}
+
+	templateID, diags := ResolveTemplateID(client, path.Join(client.getAPIEndpoint(), "workflow_job_templates"),
+		r.TemplateName.ValueString(), r.OrganizationName.ValueString())
+	if diags.HasError() {
+		return diags
+	}
+	r.TemplateID = types.Int64Value(templateID)
+	return diags
+}
+
+// LaunchWorkflowJob launches a workflow job from the Workflow Job Template. It first checks if the
+// workflow job can be launched, then POSTs to launch the workflow job.
 func (r *WorkflowJobModel) LaunchWorkflowJob(client ProviderHTTPClient) ([]byte, diag.Diagnostics) {
-	// Create new Workflow Job from workflow job template
-	var diags diag.Diagnostics
+	diags := r.ResolveTemplateID(client)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Then, check if the workflow job can be launched
+	diags.Append(r.CanWorkflowJobBeLaunched(client)...)
+	if diags.HasError() {
+		return nil, diags
+	}
 
 	// Create request body from workflow job data
 	requestBody, diagCreateReq := r.CreateRequestBody()
@@ -382,6 +670,68 @@ func (r *WorkflowJobModel) LaunchWorkflowJob(client ProviderHTTPClient) ([]byte,
 	return body, diags
 }
 
+// GetLaunchWorkflowJob performs a GET request to the Workflow Job Template launch endpoint to retrieve
+// the launch configuration.
+func (r *WorkflowJobModel) GetLaunchWorkflowJob(client ProviderHTTPClient) (launchConfig JobLaunchAPIModel, diags diag.Diagnostics) {
+	var launchURL = path.Join(client.getAPIEndpoint(), "workflow_job_templates", r.TemplateID.String(), "launch")
+
+	getResp, getBody, getErr := client.doRequest(http.MethodGet, launchURL, nil, nil)
+	diags.Append(ValidateResponse(getResp, getBody, getErr, []int{http.StatusOK})...)
+	if diags.HasError() {
+		return launchConfig, diags
+	}
+
+	err := json.Unmarshal(getBody, &launchConfig)
+	if err != nil {
+		diags.AddError(
+			"Error parsing Workflow Job Template launch configuration",
+			fmt.Sprintf("Could not parse launch configuration response: %s", err.Error()),
+		)
+		return launchConfig, diags
+	}
+
+	return launchConfig, diags
+}
+
+// CanWorkflowJobBeLaunched retrieves the launch configuration and validates that all required
+// fields are provided. It also warns when fields are provided but will be ignored.
+func (r *WorkflowJobModel) CanWorkflowJobBeLaunched(client ProviderHTTPClient) diag.Diagnostics {
+	launchConfig, diags := r.GetLaunchWorkflowJob(client)
+	if diags.HasError() {
+		return diags
+	}
+
+	// With a survey, extra_vars answers it and is not ignored. It is only required when the variables are
+	// prompted for, the required answers are validated against variables_needed_to_start.
+	var prompts []launchPrompt
+	if launchConfig.AskVariablesOnLaunch || !launchConfig.SurveyEnabled {
+		prompts = append(prompts, launchPrompt{launchConfig.AskVariablesOnLaunch, r.ExtraVars, "extra_vars"})
+	}
+	prompts = append(prompts, []launchPrompt{
+		{launchConfig.AskTagsOnLaunch, r.JobTags, "job_tags"},
+		{launchConfig.AskSkipTagsOnLaunch, r.SkipTags, "skip_tags"},
+		{launchConfig.AskDiffModeOnLaunch, r.DiffMode, "diff_mode"},
+		{launchConfig.AskLimitOnLaunch, r.Limit, "limit"},
+		{launchConfig.AskInventoryOnLaunch, r.InventoryID, "inventory_id"},
+		{launchConfig.AskCredentialOnLaunch, r.Credentials, "credentials"},
+		{launchConfig.AskExecutionEnvironmentOnLaunch, r.ExecutionEnvironmentID, "execution_environment"},
+		{launchConfig.AskLabelsOnLaunch, r.Labels, "labels"},
+		{launchConfig.AskForksOnLaunch, r.Forks, "forks"},
+		{launchConfig.AskVerbosityOnLaunch, r.Verbosity, "verbosity"},
+		{launchConfig.AskInstanceGroupsOnLaunch, r.InstanceGroups, "instance_groups"},
+		{launchConfig.AskTimeoutOnLaunch, r.Timeout, "timeout"},
+		{launchConfig.AskJobSliceCountOnLaunch, r.JobSliceCount, "job_slice_count"},
+	}...)
+	diags.Append(ValidateLaunchPrompts("Workflow Job Template", prompts)...)
+	diags.Append(ValidateSurveyVariables("Workflow Job Template", r.ExtraVars, launchConfig.VariablesNeededToStart)...)
+	if launchConfig.SurveyEnabled && IsValueProvided(r.ExtraVars) {
+		var surveySpecURL = path.Join(client.getAPIEndpoint(), "workflow_job_templates", r.TemplateID.String(), "survey_spec")
+		diags.Append(ValidateSurveySpecAnswers(client, surveySpecURL, r.ExtraVars)...)
+	}
+
+	return diags
+}
+
 func (r *WorkflowJobResourceModel) LaunchWorkflowJobWithResponse(client ProviderHTTPClient) diag.Diagnostics {
 	body, diags := r.LaunchWorkflowJob(client)
 	if diags.HasError() {
@@ -389,3 +739,91 @@ func (r *WorkflowJobResourceModel) LaunchWorkflowJobWithResponse(client Provider
 	}
 	return r.ParseHTTPResponse(body)
 }
+
+// CheckWorkflowJobResults returns an error when the workflow job did not succeed, or a warning if the job
+// results are ignored. The diagnostic details include the workflow job URL and its failed jobs, with the
+// failed hosts and the last lines of the output of each failed job.
+func (r *WorkflowJobResourceModel) CheckWorkflowJobResults(ctx context.Context, client ProviderHTTPClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	status := r.Status.ValueString()
+	if status == statusSuccessfulConst {
+		return diags
+	}
+
+	detail := fmt.Sprintf("API Path: %s", r.URL.ValueString())
+	failedJobs, failedJobsDiags := r.FailedJobs(ctx, client)
+	if failedJobsDiags.HasError() {
+		tflog.Warn(ctx, "Could not retrieve the failed jobs of the workflow job", map[string]any{"url": r.URL.ValueString()})
+	}
+	if len(failedJobs) > 0 {
+		names := make([]string, 0, len(failedJobs))
+		for _, job := range failedJobs {
+			names = append(names, describeWorkflowNodeJob(job))
+		}
+		detail += fmt.Sprintf("\nFailed jobs: %s", strings.Join(names, ", "))
+	}
+	for _, job := range failedJobs {
+		detail += describeFailedWorkflowNodeJob(ctx, client, job)
+	}
+
+	if r.IgnoreJobResults.ValueBool() {
+		diags.AddWarning(fmt.Sprintf("AAP workflow job %s", status), detail)
+	} else {
+		diags.AddError(fmt.Sprintf("AAP workflow job %s", status), detail)
+	}
+	return diags
+}
+
+// FailedJobs returns the jobs of the failed workflow job nodes.
+func (r *WorkflowJobResourceModel) FailedJobs(ctx context.Context, client ProviderHTTPClient) ([]WorkflowJobNodeJobAPIModel, diag.Diagnostics) {
+	nodes, diags := GetWorkflowJobNodes(ctx, client, r.URL.ValueString(), map[string]string{"job__failed": "true"})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	failedJobs := make([]WorkflowJobNodeJobAPIModel, 0, len(nodes.Results))
+	for _, node := range nodes.Results {
+		failedJobs = append(failedJobs, node.SummaryFields.Job)
+	}
+	return failedJobs, diags
+}
+
+// describeWorkflowNodeJob formats the job of a workflow job node as "name (id: status)".
+func describeWorkflowNodeJob(job WorkflowJobNodeJobAPIModel) string {
+	return fmt.Sprintf("%s (%d: %s)", job.Name, job.ID, job.Status)
+}
+
+// describeFailedWorkflowNodeJob returns the failed hosts and the last lines of the output of a failed
+// playbook job of a workflow job. Other job types are only listed in the failed jobs.
+func describeFailedWorkflowNodeJob(ctx context.Context, client ProviderHTTPClient, job WorkflowJobNodeJobAPIModel) string {
+	if job.ID == 0 || job.Type != "job" {
+		return ""
+	}
+
+	jobURL := path.Join(client.getAPIEndpoint(), "jobs", strconv.FormatInt(job.ID, 10))
+	detail := fmt.Sprintf("\n\nJob %s:", describeWorkflowNodeJob(job))
+
+	hostSummaries, summariesDiags := GetJobHostSummaries(ctx, client, jobURL)
+	if summariesDiags.HasError() {
+		tflog.Warn(ctx, "Could not retrieve the job host summaries", map[string]any{"url": jobURL})
+	}
+	var failedHosts []string
+	for _, summary := range hostSummaries {
+		if summary.Failures > 0 || summary.Dark > 0 {
+			failedHosts = append(failedHosts, summary.HostName)
+		}
+	}
+	if len(failedHosts) > 0 {
+		sort.Strings(failedHosts)
+		detail += fmt.Sprintf("\nFailed hosts: %s", strings.Join(failedHosts, ", "))
+	}
+
+	stdout, stdoutDiags := GetJobStdoutTail(client, jobURL, jobFailureStdoutTailLines)
+	if stdoutDiags.HasError() {
+		tflog.Warn(ctx, "Could not retrieve the job output", map[string]any{"url": jobURL})
+	} else if stdout != "" {
+		detail += fmt.Sprintf("\nLast lines of the job output:\n%s", stdout)
+	}
+	return detail
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"reflect"
 	"regexp"
@@ -14,12 +15,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"go.uber.org/mock/gomock"
 )
 
 const (
@@ -58,7 +62,7 @@ func TestWorkflowJobResourceCreateRequestBody(t *testing.T) {
 			name: "unknown values",
 			input: WorkflowJobResourceModel{
 				WorkflowJobModel: WorkflowJobModel{
-					ExtraVars:   customtypes.NewAAPCustomStringUnknown(),
+					ExtraVars:   customtypes.NewAAPCustomVariablesUnknown(),
 					InventoryID: basetypes.NewInt64Unknown(),
 					TemplateID:  types.Int64Value(1),
 				},
@@ -69,7 +73,7 @@ func TestWorkflowJobResourceCreateRequestBody(t *testing.T) {
 			name: "null values",
 			input: WorkflowJobResourceModel{
 				WorkflowJobModel: WorkflowJobModel{
-					ExtraVars:   customtypes.NewAAPCustomStringNull(),
+					ExtraVars:   customtypes.NewAAPCustomVariablesNull(),
 					InventoryID: basetypes.NewInt64Null(),
 					TemplateID:  types.Int64Value(1),
 				},
@@ -80,7 +84,7 @@ func TestWorkflowJobResourceCreateRequestBody(t *testing.T) {
 			name: "extra vars only",
 			input: WorkflowJobResourceModel{
 				WorkflowJobModel: WorkflowJobModel{
-					ExtraVars:   customtypes.NewAAPCustomStringValue("{\"test_name\":\"extra_vars\", \"provider\":\"aap\"}"),
+					ExtraVars:   customtypes.NewAAPCustomVariablesValue("{\"test_name\":\"extra_vars\", \"provider\":\"aap\"}"),
 					InventoryID: basetypes.NewInt64Null(),
 				},
 			},
@@ -90,7 +94,7 @@ func TestWorkflowJobResourceCreateRequestBody(t *testing.T) {
 			name: "inventory vars only",
 			input: WorkflowJobResourceModel{
 				WorkflowJobModel: WorkflowJobModel{
-					ExtraVars:   customtypes.NewAAPCustomStringNull(),
+					ExtraVars:   customtypes.NewAAPCustomVariablesNull(),
 					InventoryID: basetypes.NewInt64Value(201),
 				},
 			},
@@ -100,12 +104,35 @@ func TestWorkflowJobResourceCreateRequestBody(t *testing.T) {
 			name: "combined",
 			input: WorkflowJobResourceModel{
 				WorkflowJobModel: WorkflowJobModel{
-					ExtraVars:   customtypes.NewAAPCustomStringValue("{\"test_name\":\"extra_vars\", \"provider\":\"aap\"}"),
+					ExtraVars:   customtypes.NewAAPCustomVariablesValue("{\"test_name\":\"extra_vars\", \"provider\":\"aap\"}"),
 					InventoryID: basetypes.NewInt64Value(3),
 				},
 			},
 			expected: []byte(`{"inventory":3,"extra_vars":"{\"test_name\":\"extra_vars\", \"provider\":\"aap\"}"}`),
 		},
+		{
+			name: "prompted fields",
+			input: WorkflowJobResourceModel{
+				WorkflowJobModel: WorkflowJobModel{
+					InventoryID:            basetypes.NewInt64Value(3),
+					Limit:                  customtypes.NewAAPCustomStringValue("webservers"),
+					JobTags:                customtypes.NewAAPCustomStringValue("deploy"),
+					SkipTags:               customtypes.NewAAPCustomStringValue("debug"),
+					DiffMode:               types.BoolValue(true),
+					Verbosity:              types.Int64Value(1),
+					ExecutionEnvironmentID: types.Int64Value(4),
+					Forks:                  types.Int64Value(5),
+					JobSliceCount:          types.Int64Value(2),
+					Timeout:                types.Int64Value(600),
+					InstanceGroups:         basetypes.NewListValueMust(types.Int64Type, []attr.Value{types.Int64Value(6)}),
+					Credentials:            basetypes.NewListValueMust(types.Int64Type, []attr.Value{types.Int64Value(7), types.Int64Value(8)}),
+					Labels:                 basetypes.NewListValueMust(types.Int64Type, []attr.Value{types.Int64Value(9)}),
+				},
+			},
+			expected: []byte(`{"inventory":3,"limit":"webservers","job_tags":"deploy","skip_tags":"debug","diff_mode":true,` +
+				`"verbosity":1,"execution_environment":4,"forks":5,"job_slice_count":2,"timeout":600,` +
+				`"instance_groups":[6],"credentials":[7,8],"labels":[9]}`),
+		},
 		{
 			name: "manual_triggers",
 			input: WorkflowJobResourceModel{
@@ -147,10 +174,179 @@ func TestWorkflowJobResourceCreateRequestBody(t *testing.T) {
 	}
 }
 
+func TestWorkflowJobModelCanWorkflowJobBeLaunched(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		launchConfig     JobLaunchAPIModel
+		model            WorkflowJobModel
+		expectedErrors   []string
+		expectedWarnings []string
+	}{
+		{
+			name:         "all fields optional - no errors",
+			launchConfig: JobLaunchAPIModel{},
+			model:        WorkflowJobModel{TemplateID: types.Int64Value(1)},
+		},
+		{
+			name:         "prompted fields provided",
+			launchConfig: JobLaunchAPIModel{AskLimitOnLaunch: true, AskTagsOnLaunch: true, AskLabelsOnLaunch: true},
+			model: WorkflowJobModel{
+				TemplateID: types.Int64Value(1),
+				Limit:      customtypes.NewAAPCustomStringValue("all"),
+				JobTags:    customtypes.NewAAPCustomStringValue("deploy"),
+				Labels:     basetypes.NewListValueMust(types.Int64Type, []attr.Value{types.Int64Value(1)}),
+			},
+		},
+		{
+			name:           "prompted field not provided",
+			launchConfig:   JobLaunchAPIModel{AskInventoryOnLaunch: true},
+			model:          WorkflowJobModel{TemplateID: types.Int64Value(1), InventoryID: types.Int64Null()},
+			expectedErrors: []string{"Workflow Job Template requires 'inventory_id' to be provided at launch"},
+		},
+		{
+			name:         "extra_vars not provided to a survey",
+			launchConfig: JobLaunchAPIModel{SurveyEnabled: true},
+			model:        WorkflowJobModel{TemplateID: types.Int64Value(1), ExtraVars: customtypes.NewAAPCustomVariablesNull()},
+		},
+		{
+			name:         "inventory computed by the resource",
+			launchConfig: JobLaunchAPIModel{},
+			model:        WorkflowJobModel{TemplateID: types.Int64Value(1), InventoryID: types.Int64Unknown()},
+		},
+		{
+			name:         "field not prompted for by the workflow job template",
+			launchConfig: JobLaunchAPIModel{AskLimitOnLaunch: true, AskVariablesOnLaunch: true},
+			model: WorkflowJobModel{
+				TemplateID: types.Int64Value(1),
+				ExtraVars:  customtypes.NewAAPCustomVariablesValue(`{"key": "value"}`),
+				Limit:      customtypes.NewAAPCustomStringValue("all"),
+				Forks:      types.Int64Value(10),
+			},
+			expectedWarnings: []string{"'forks' is provided but the Workflow Job Template does not allow it to be specified at launch"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockProviderHTTPClient(ctrl)
+			mockClient.EXPECT().getAPIEndpoint().Return("/api/v2")
+
+			configJSON, _ := json.Marshal(tc.launchConfig)
+			mockClient.EXPECT().
+				doRequest(http.MethodGet, "/api/v2/workflow_job_templates/1/launch", nil, nil).
+				Return(&http.Response{StatusCode: http.StatusOK}, configJSON, nil)
+
+			diags := tc.model.CanWorkflowJobBeLaunched(mockClient)
+
+			var errors, warnings []string
+			for _, d := range diags.Errors() {
+				errors = append(errors, d.Detail())
+			}
+			for _, d := range diags.Warnings() {
+				warnings = append(warnings, d.Detail())
+			}
+			if !reflect.DeepEqual(tc.expectedErrors, errors) {
+				t.Errorf("Expected errors (%v), got (%v)", tc.expectedErrors, errors)
+			}
+			if !reflect.DeepEqual(tc.expectedWarnings, warnings) {
+				t.Errorf("Expected warnings (%v), got (%v)", tc.expectedWarnings, warnings)
+			}
+		})
+	}
+}
+
+func TestWorkflowJobResourceModifyPlan(t *testing.T) {
+	ctx := t.Context()
+	schemaResponse := &fwresource.SchemaResponse{}
+	NewWorkflowJobResource().Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+	workflowJobSchema := schemaResponse.Schema
+
+	newModel := func(inventoryID types.Int64) WorkflowJobResourceModel {
+		return WorkflowJobResourceModel{
+			WorkflowJobModel: WorkflowJobModel{
+				TemplateID:     types.Int64Value(1),
+				InventoryID:    inventoryID,
+				InstanceGroups: types.ListNull(types.Int64Type),
+				Credentials:    types.ListNull(types.Int64Type),
+				Labels:         types.ListNull(types.Int64Type),
+			},
+			IgnoredFields: types.ListNull(types.StringType),
+			Triggers:      types.MapNull(types.StringType),
+		}
+	}
+	newPlan := func(t *testing.T, model WorkflowJobResourceModel) tfsdk.Plan {
+		plan := tfsdk.Plan{Schema: workflowJobSchema, Raw: tftypes.NewValue(workflowJobSchema.Type().TerraformType(ctx), nil)}
+		if diags := plan.Set(ctx, &model); diags.HasError() {
+			t.Fatal(diags.Errors())
+		}
+		return plan
+	}
+
+	var testTable = []struct {
+		name           string
+		plan           WorkflowJobResourceModel
+		config         WorkflowJobResourceModel
+		launchConfig   string
+		expectedErrors []string
+	}{
+		{name: "provided prompts", plan: newModel(types.Int64Value(2)), config: newModel(types.Int64Value(2))},
+		{name: "unknown prompts", plan: newModel(types.Int64Unknown()), config: newModel(types.Int64Unknown())},
+		{name: "omitted prompts", plan: newModel(types.Int64Unknown()), config: newModel(types.Int64Null())},
+		{
+			name:           "missing labels",
+			plan:           newModel(types.Int64Value(2)),
+			config:         newModel(types.Int64Value(2)),
+			launchConfig:   `{"ask_labels_on_launch": true}`,
+			expectedErrors: []string{"Missing required field"},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			launchConfig := test.launchConfig
+			if launchConfig == "" {
+				launchConfig = `{"ask_inventory_on_launch": true}`
+			}
+			mockClient := NewMockProviderHTTPClient(ctrl)
+			mockClient.EXPECT().getAPIEndpoint().Return("/api/v2")
+			mockClient.EXPECT().doRequest(http.MethodGet, "/api/v2/workflow_job_templates/1/launch", nil, nil).
+				Return(&http.Response{StatusCode: http.StatusOK}, []byte(launchConfig), nil)
+
+			plan := newPlan(t, test.plan)
+			config := newPlan(t, test.config)
+			req := fwresource.ModifyPlanRequest{
+				Plan:   plan,
+				State:  tfsdk.State{Schema: workflowJobSchema, Raw: tftypes.NewValue(workflowJobSchema.Type().TerraformType(ctx), nil)},
+				Config: tfsdk.Config{Schema: workflowJobSchema, Raw: config.Raw},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+
+			r := WorkflowJobResource{client: mockClient}
+			r.ModifyPlan(ctx, req, resp)
+
+			var errors []string
+			for _, d := range resp.Diagnostics.Errors() {
+				errors = append(errors, d.Summary())
+			}
+			if !reflect.DeepEqual(test.expectedErrors, errors) {
+				t.Errorf("Expected errors (%v), got (%v)", test.expectedErrors, resp.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
 func TestWorkflowJobResourceParseHTTPResponse(t *testing.T) {
 	templateID := basetypes.NewInt64Value(1)
 	inventoryID := basetypes.NewInt64Value(2)
-	extraVars := customtypes.NewAAPCustomStringNull()
+	extraVars := customtypes.NewAAPCustomVariablesNull()
 	jsonError := diag.Diagnostics{}
 	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
 
@@ -280,6 +476,89 @@ func TestWorkflowJobResourceConfigure(t *testing.T) {
 	}
 }
 
+func TestWorkflowJobResourceCheckWorkflowJobResults(t *testing.T) {
+	workflowJobURL := "/api/v2/workflow_jobs/3/"
+	failedNodesParams := func(page string) map[string]string {
+		return map[string]string{"job__failed": "true", "order_by": "id", "page": page, "page_size": "200"}
+	}
+	failedDetail := "API Path: /api/v2/workflow_jobs/3/\nFailed jobs: Update project (11: failed), Deploy (12: failed)" +
+		"\n\nJob Deploy (12: failed):\nFailed hosts: db1, web2\nLast lines of the job output:\nfatal: [web2]\nPLAY RECAP"
+
+	var testTable = []struct {
+		name          string
+		status        string
+		ignore        bool
+		expectNodes   bool
+		expectedDiags func() diag.Diagnostics
+	}{
+		{
+			name:          "successful workflow job",
+			status:        statusSuccessfulConst,
+			expectedDiags: func() diag.Diagnostics { return diag.Diagnostics{} },
+		},
+		{
+			name:        "failed workflow job",
+			status:      "failed",
+			expectNodes: true,
+			expectedDiags: func() diag.Diagnostics {
+				diags := diag.Diagnostics{}
+				diags.AddError("AAP workflow job failed", failedDetail)
+				return diags
+			},
+		},
+		{
+			name:        "failed workflow job with ignored results",
+			status:      "failed",
+			ignore:      true,
+			expectNodes: true,
+			expectedDiags: func() diag.Diagnostics {
+				diags := diag.Diagnostics{}
+				diags.AddWarning("AAP workflow job failed", failedDetail)
+				return diags
+			},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockProviderHTTPClient(ctrl)
+			if test.expectNodes {
+				mockClient.EXPECT().GetWithParams("/api/v2/workflow_jobs/3/workflow_nodes", failedNodesParams("1")).Return(
+					[]byte(`{"next":"/api/v2/workflow_jobs/3/workflow_nodes/?page=2","results":[`+
+						`{"summary_fields":{"job":{"id":11,"name":"Update project","status":"failed","type":"project_update"}}}]}`),
+					diag.Diagnostics{},
+				)
+				mockClient.EXPECT().GetWithParams("/api/v2/workflow_jobs/3/workflow_nodes", failedNodesParams("2")).Return(
+					[]byte(`{"next":null,"results":[{"summary_fields":{"job":{"id":12,"name":"Deploy","status":"failed","type":"job"}}}]}`),
+					diag.Diagnostics{},
+				)
+				mockClient.EXPECT().getAPIEndpoint().Return("/api/v2")
+				mockClient.EXPECT().GetWithParams("/api/v2/jobs/12/job_host_summaries", map[string]string{"page": "1", "page_size": "200"}).Return(
+					[]byte(`{"next":null,"results":[{"host_name":"web2","failures":1},{"host_name":"web1","ok":2},{"host_name":"db1","dark":1}]}`),
+					diag.Diagnostics{},
+				)
+				mockClient.EXPECT().GetWithParams("/api/v2/jobs/12/stdout", map[string]string{"format": "txt"}).Return(
+					[]byte("fatal: [web2]\nPLAY RECAP\n"),
+					diag.Diagnostics{},
+				)
+			}
+
+			model := WorkflowJobResourceModel{
+				URL:              types.StringValue(workflowJobURL),
+				Status:           types.StringValue(test.status),
+				IgnoreJobResults: types.BoolValue(test.ignore),
+			}
+			diags := model.CheckWorkflowJobResults(t.Context(), mockClient)
+			if !test.expectedDiags().Equal(diags) {
+				t.Errorf("Expected diagnostics (%s), actual was (%s)", test.expectedDiags(), diags)
+			}
+		})
+	}
+}
+
 // Acceptance tests
 func getWorkflowJobResourceFromStateFile(s *terraform.State) (map[string]interface{}, error) {
 	for _, rs := range s.RootModule().Resources {
@@ -364,6 +643,26 @@ func TestAccAAPWorkflowJob_Basic(t *testing.T) {
 	})
 }
 
+func TestAccAAPWorkflowJob_TemplateName(t *testing.T) {
+	jobTemplateID := os.Getenv("AAP_TEST_WORKFLOW_JOB_TEMPLATE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccWorkflowJobResourcePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowJobWithTemplateName(jobTemplateID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceNameWorkflowJob, "url", regexp.MustCompile("^/api(/controller)?/v2/workflow_jobs/[0-9]*/$")),
+					// The workflow job template ID is resolved from its name
+					resource.TestCheckResourceAttr(resourceNameWorkflowJob, "workflow_job_template_id", jobTemplateID),
+					testAccCheckWorkflowJobExists,
+				),
+			},
+		},
+	})
+}
+
 func TestAccAAPWorkflowJobWithNoInventoryID(t *testing.T) {
 	jobTemplateID := os.Getenv("AAP_TEST_WORKFLOW_INVENTORY_ID")
 	inventoryID := os.Getenv("AAP_TEST_INVENTORY_FOR_WF_ID")
@@ -533,9 +832,16 @@ func TestAccAAPWorkflowJob_waitForCompletionWithFailure(t *testing.T) {
 		PreCheck:                 func() { testAccWorkflowJobResourcePreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// An unsuccessful workflow job fails the apply by default
 			{
-				Config: testAccBasicWorkflowJob(jobTemplateID),
+				Config:      testAccBasicWorkflowJob(jobTemplateID),
+				ExpectError: regexp.MustCompile("AAP workflow job failed"),
+			},
+			// The tainted workflow job is launched again, and its failure is ignored
+			{
+				Config: testAccWorkflowJobIgnoreJobResults(jobTemplateID),
 				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aap_workflow_job.test", "ignore_job_results", "true"),
 					resource.TestMatchResourceAttr("aap_workflow_job.test", "status", regexp.MustCompile("^failed$")),
 					resource.TestMatchResourceAttr("aap_workflow_job.test", "url", regexp.MustCompile("^/api(/controller)?/v2/workflow_jobs/[0-9]*/$")),
 					testAccCheckWorkflowJobExists,
@@ -554,6 +860,30 @@ resource %q "test" {
 `, baseResourceNameWorkflowJob, jobTemplateID)
 }
 
+func testAccWorkflowJobWithTemplateName(jobTemplateID string) string {
+	return fmt.Sprintf(`
+data "aap_workflow_job_template" "test" {
+	id = %s
+}
+
+resource %q "test" {
+	workflow_job_template_name = data.aap_workflow_job_template.test.name
+	organization_name          = data.aap_workflow_job_template.test.organization_name
+	wait_for_completion        = true
+}
+`, jobTemplateID, baseResourceNameWorkflowJob)
+}
+
+func testAccWorkflowJobIgnoreJobResults(jobTemplateID string) string {
+	return fmt.Sprintf(`
+resource %q "test" {
+	workflow_job_template_id  = %s
+	wait_for_completion       = true
+	ignore_job_results        = true
+}
+`, baseResourceNameWorkflowJob, jobTemplateID)
+}
+
 func testAccWorkflowJobWithNoInventoryID(workflowJobTemplateID string) string {
 	return fmt.Sprintf(`
 resource "%s" "wf_job" {
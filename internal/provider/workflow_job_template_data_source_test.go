@@ -61,7 +61,7 @@ func TestWorkflowJobTemplateDataSourceParseHTTPResponse(t *testing.T) {
 						Description: tftypes.StringNull(),
 						Name:        tftypes.StringNull(),
 						NamedURL:    tftypes.StringNull(),
-						Variables:   customtypes.NewAAPCustomStringNull(),
+						Variables:   customtypes.NewAAPCustomVariablesNull(),
 					},
 					Organization:     tftypes.Int64Value(2),
 					OrganizationName: tftypes.StringNull(),
@@ -83,7 +83,7 @@ func TestWorkflowJobTemplateDataSourceParseHTTPResponse(t *testing.T) {
 						Description: tftypes.StringValue("My Test Job Template"),
 						NamedURL:    tftypes.StringNull(),
 						Name:        tftypes.StringValue("my job template"),
-						Variables:   customtypes.NewAAPCustomStringValue("{\"foo\":\"bar\"}"),
+						Variables:   customtypes.NewAAPCustomVariablesValue("{\"foo\":\"bar\"}"),
 					},
 					Organization:     tftypes.Int64Value(2),
 					OrganizationName: tftypes.StringNull(),